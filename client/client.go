@@ -106,6 +106,22 @@ func (c *Client) Users() (Users, error) {
 	return users, nil
 }
 
+// Jobs returns the feed refreshes queued or running in the background (admin only).
+func (c *Client) Jobs() (Jobs, error) {
+	body, err := c.request.Get("/v1/jobs")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var jobs Jobs
+	if err := json.NewDecoder(body).Decode(&jobs); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return jobs, nil
+}
+
 // UserByID returns a single user.
 func (c *Client) UserByID(userID int64) (*User, error) {
 	body, err := c.request.Get(fmt.Sprintf("/v1/users/%d", userID))
@@ -318,6 +334,31 @@ func (c *Client) Export() ([]byte, error) {
 	return opml, nil
 }
 
+// CategoryJSONFeed returns the most recent entries of a category as a JSON Feed document.
+func (c *Client) CategoryJSONFeed(categoryID int64) ([]byte, error) {
+	return c.jsonFeed(fmt.Sprintf("/v1/categories/%d/feed.json", categoryID))
+}
+
+// StarredJSONFeed returns the most recent starred entries as a JSON Feed document.
+func (c *Client) StarredJSONFeed() ([]byte, error) {
+	return c.jsonFeed("/v1/starred/feed.json")
+}
+
+// SharedJSONFeed returns the most recent shared entries as a JSON Feed document.
+func (c *Client) SharedJSONFeed() ([]byte, error) {
+	return c.jsonFeed("/v1/shared/feed.json")
+}
+
+func (c *Client) jsonFeed(path string) ([]byte, error) {
+	body, err := c.request.Get(path)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return io.ReadAll(body)
+}
+
 // Import imports an OPML file.
 func (c *Client) Import(f io.ReadCloser) error {
 	_, err := c.request.PostFile("/v1/import", f)
@@ -340,6 +381,22 @@ func (c *Client) Feed(feedID int64) (*Feed, error) {
 	return feed, nil
 }
 
+// FeedHistory gets the recent refresh attempts of a feed, the most recent first.
+func (c *Client) FeedHistory(feedID int64) (FeedRefreshes, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/feeds/%d/history", feedID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var refreshes FeedRefreshes
+	if err := json.NewDecoder(body).Decode(&refreshes); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return refreshes, nil
+}
+
 // CreateFeed creates a new feed.
 func (c *Client) CreateFeed(feedCreationRequest *FeedCreationRequest) (int64, error) {
 	body, err := c.request.Post("/v1/feeds", feedCreationRequest)
@@ -360,6 +417,22 @@ func (c *Client) CreateFeed(feedCreationRequest *FeedCreationRequest) (int64, er
 	return r.FeedID, nil
 }
 
+// PreviewFeed fetches and parses a feed without subscribing, to test the page selectors.
+func (c *Client) PreviewFeed(feedCreationRequest *FeedCreationRequest) (*Feed, error) {
+	body, err := c.request.Post("/v1/feeds/preview", feedCreationRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var feed *Feed
+	if err := json.NewDecoder(body).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return feed, nil
+}
+
 // UpdateFeed updates a feed.
 func (c *Client) UpdateFeed(feedID int64, feedChanges *FeedModificationRequest) (*Feed, error) {
 	body, err := c.request.Put(fmt.Sprintf("/v1/feeds/%d", feedID), feedChanges)
@@ -382,6 +455,143 @@ func (c *Client) MarkFeedAsRead(feedID int64) error {
 	return err
 }
 
+// ApplyFeedFilterRules applies the current filter rules to the stored entries of a feed.
+func (c *Client) ApplyFeedFilterRules(feedID int64, filterRulesRequest *FilterRulesRequest) (*FilterRulesResult, error) {
+	return c.applyFilterRules(fmt.Sprintf("/v1/feeds/%d/apply-filter-rules", feedID), filterRulesRequest)
+}
+
+// ApplyCategoryFilterRules applies the current filter rules to the stored entries of a category.
+func (c *Client) ApplyCategoryFilterRules(categoryID int64, filterRulesRequest *FilterRulesRequest) (*FilterRulesResult, error) {
+	return c.applyFilterRules(fmt.Sprintf("/v1/categories/%d/apply-filter-rules", categoryID), filterRulesRequest)
+}
+
+// ApplyUserFilterRules applies the current filter rules to the stored entries of all feeds of a user.
+func (c *Client) ApplyUserFilterRules(userID int64, filterRulesRequest *FilterRulesRequest) (*FilterRulesResult, error) {
+	return c.applyFilterRules(fmt.Sprintf("/v1/users/%d/apply-filter-rules", userID), filterRulesRequest)
+}
+
+func (c *Client) applyFilterRules(path string, filterRulesRequest *FilterRulesRequest) (*FilterRulesResult, error) {
+	body, err := c.request.Put(path, filterRulesRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var result *FilterRulesResult
+	if err := json.NewDecoder(body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return result, nil
+}
+
+// PreviewScraperRules downloads an entry of a feed and returns its content processed with the given scraper and rewrite rules.
+func (c *Client) PreviewScraperRules(feedID int64, previewRequest *ScraperPreviewRequest) (*ScraperPreview, error) {
+	body, err := c.request.Post(fmt.Sprintf("/v1/feeds/%d/scraper-preview", feedID), previewRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var preview *ScraperPreview
+	if err := json.NewDecoder(body).Decode(&preview); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return preview, nil
+}
+
+// SiteRules returns the site rules of the user and the instance-wide site rules.
+func (c *Client) SiteRules() (SiteRules, error) {
+	body, err := c.request.Get("/v1/site-rules")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var siteRules SiteRules
+	if err := json.NewDecoder(body).Decode(&siteRules); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return siteRules, nil
+}
+
+// SiteRule gets a site rule.
+func (c *Client) SiteRule(siteRuleID int64) (*SiteRule, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/site-rules/%d", siteRuleID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var siteRule *SiteRule
+	if err := json.NewDecoder(body).Decode(&siteRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return siteRule, nil
+}
+
+// CreateSiteRule creates a site rule, only administrators can create instance-wide rules.
+func (c *Client) CreateSiteRule(siteRuleRequest *SiteRuleRequest) (*SiteRule, error) {
+	body, err := c.request.Post("/v1/site-rules", siteRuleRequest)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var siteRule *SiteRule
+	if err := json.NewDecoder(body).Decode(&siteRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return siteRule, nil
+}
+
+// UpdateSiteRule updates a site rule.
+func (c *Client) UpdateSiteRule(siteRuleID int64, siteRuleChanges *SiteRuleModificationRequest) (*SiteRule, error) {
+	body, err := c.request.Put(fmt.Sprintf("/v1/site-rules/%d", siteRuleID), siteRuleChanges)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var siteRule *SiteRule
+	if err := json.NewDecoder(body).Decode(&siteRule); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return siteRule, nil
+}
+
+// DeleteSiteRule removes a site rule.
+func (c *Client) DeleteSiteRule(siteRuleID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/site-rules/%d", siteRuleID))
+}
+
+// ExportSiteRules exports the site rules as a JSON document.
+func (c *Client) ExportSiteRules() ([]byte, error) {
+	body, err := c.request.Get("/v1/site-rules/export")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	siteRules, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return siteRules, nil
+}
+
+// ImportSiteRules imports a JSON document of site rules, the rules having the same domain are replaced.
+func (c *Client) ImportSiteRules(f io.ReadCloser) error {
+	_, err := c.request.PostFile("/v1/site-rules/import", f)
+	return err
+}
+
 // RefreshAllFeeds refreshes all feeds.
 func (c *Client) RefreshAllFeeds() error {
 	_, err := c.request.Put("/v1/feeds/refresh", nil)
@@ -575,6 +785,86 @@ func (c *Client) FetchEntryOriginalContent(entryID int64) (string, error) {
 	return response.Content, nil
 }
 
+// Enclosure gets a single enclosure.
+func (c *Client) Enclosure(enclosureID int64) (*Enclosure, error) {
+	body, err := c.request.Get(fmt.Sprintf("/v1/enclosures/%d", enclosureID))
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	var enclosure *Enclosure
+	if err := json.NewDecoder(body).Decode(&enclosure); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return enclosure, nil
+}
+
+// UpdateEnclosure saves the playback position or the played state of an enclosure.
+func (c *Client) UpdateEnclosure(enclosureID int64, enclosureChanges *EnclosureUpdateRequest) error {
+	_, err := c.request.Put(fmt.Sprintf("/v1/enclosures/%d", enclosureID), enclosureChanges)
+	return err
+}
+
+// ListeningQueue returns the listening queue, in playing order.
+func (c *Client) ListeningQueue() (ListeningItems, error) {
+	body, err := c.request.Get("/v1/listening-queue")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return decodeListeningItems(body)
+}
+
+// AddToListeningQueue appends an enclosure at the end of the listening queue.
+func (c *Client) AddToListeningQueue(enclosureID int64) (ListeningItems, error) {
+	body, err := c.request.Post("/v1/listening-queue", map[string]int64{"enclosure_id": enclosureID})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return decodeListeningItems(body)
+}
+
+// ReorderListeningQueue moves the given enclosures at the beginning of the listening queue.
+func (c *Client) ReorderListeningQueue(enclosureIDs []int64) (ListeningItems, error) {
+	body, err := c.request.Put("/v1/listening-queue", map[string][]int64{"enclosure_ids": enclosureIDs})
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return decodeListeningItems(body)
+}
+
+// RemoveFromListeningQueue removes an enclosure from the listening queue.
+func (c *Client) RemoveFromListeningQueue(enclosureID int64) error {
+	return c.request.Delete(fmt.Sprintf("/v1/listening-queue/%d", enclosureID))
+}
+
+// ContinueListening returns the enclosures partially played, the most recently listened first.
+func (c *Client) ContinueListening() (ListeningItems, error) {
+	body, err := c.request.Get("/v1/continue-listening")
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return decodeListeningItems(body)
+}
+
+func decodeListeningItems(body io.Reader) (ListeningItems, error) {
+	var items ListeningItems
+	if err := json.NewDecoder(body).Decode(&items); err != nil {
+		return nil, fmt.Errorf("miniflux: response error (%v)", err)
+	}
+
+	return items, nil
+}
+
 // FetchCounters fetches feed counters.
 func (c *Client) FetchCounters() (*FeedCounters, error) {
 	body, err := c.request.Get("/v1/feeds/counters")
@@ -15,6 +15,12 @@ const (
 	EntryStatusRemoved = "removed"
 )
 
+// Actions applied to stored entries matching the filter rules.
+const (
+	FilterActionRead   = "read"
+	FilterActionRemove = "remove"
+)
+
 // User represents a user in the system.
 type User struct {
 	ID                     int64      `json:"id"`
@@ -42,6 +48,10 @@ type User struct {
 	CategoriesSortingOrder string     `json:"categories_sorting_order"`
 	MarkReadOnView         bool       `json:"mark_read_on_view"`
 	MediaPlaybackRate      float64    `json:"media_playback_rate"`
+	BlockFilterEntryRules  string     `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules   string     `json:"keep_filter_entry_rules"`
+	EntryActionRules       string     `json:"entry_action_rules"`
+	DeduplicateEntries     bool       `json:"deduplicate_entries"`
 }
 
 func (u User) String() string {
@@ -82,6 +92,10 @@ type UserModificationRequest struct {
 	CategoriesSortingOrder *string  `json:"categories_sorting_order"`
 	MarkReadOnView         *bool    `json:"mark_read_on_view"`
 	MediaPlaybackRate      *float64 `json:"media_playback_rate"`
+	BlockFilterEntryRules  *string  `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules   *string  `json:"keep_filter_entry_rules"`
+	EntryActionRules       *string  `json:"entry_action_rules"`
+	DeduplicateEntries     *bool    `json:"deduplicate_entries"`
 }
 
 // Users represents a list of users.
@@ -117,76 +131,152 @@ type Subscriptions []*Subscription
 
 // Feed represents a Miniflux feed.
 type Feed struct {
-	ID                          int64     `json:"id"`
-	UserID                      int64     `json:"user_id"`
-	FeedURL                     string    `json:"feed_url"`
-	SiteURL                     string    `json:"site_url"`
-	Title                       string    `json:"title"`
-	CheckedAt                   time.Time `json:"checked_at,omitempty"`
-	EtagHeader                  string    `json:"etag_header,omitempty"`
-	LastModifiedHeader          string    `json:"last_modified_header,omitempty"`
-	ParsingErrorMsg             string    `json:"parsing_error_message,omitempty"`
-	ParsingErrorCount           int       `json:"parsing_error_count,omitempty"`
-	Disabled                    bool      `json:"disabled"`
-	IgnoreHTTPCache             bool      `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool      `json:"allow_self_signed_certificates"`
-	FetchViaProxy               bool      `json:"fetch_via_proxy"`
-	ScraperRules                string    `json:"scraper_rules"`
-	RewriteRules                string    `json:"rewrite_rules"`
-	BlocklistRules              string    `json:"blocklist_rules"`
-	KeeplistRules               string    `json:"keeplist_rules"`
-	Crawler                     bool      `json:"crawler"`
-	UserAgent                   string    `json:"user_agent"`
-	Cookie                      string    `json:"cookie"`
-	Username                    string    `json:"username"`
-	Password                    string    `json:"password"`
-	Category                    *Category `json:"category,omitempty"`
-	HideGlobally                bool      `json:"hide_globally"`
-	DisableHTTP2                bool      `json:"disable_http2"`
+	ID                          int64         `json:"id"`
+	UserID                      int64         `json:"user_id"`
+	FeedURL                     string        `json:"feed_url"`
+	SiteURL                     string        `json:"site_url"`
+	Title                       string        `json:"title"`
+	CheckedAt                   time.Time     `json:"checked_at,omitempty"`
+	EtagHeader                  string        `json:"etag_header,omitempty"`
+	LastModifiedHeader          string        `json:"last_modified_header,omitempty"`
+	ParsingErrorMsg             string        `json:"parsing_error_message,omitempty"`
+	ParsingErrorCount           int           `json:"parsing_error_count,omitempty"`
+	Disabled                    bool          `json:"disabled"`
+	IgnoreHTTPCache             bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool          `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool          `json:"fetch_via_proxy"`
+	ScraperRules                string        `json:"scraper_rules"`
+	RewriteRules                string        `json:"rewrite_rules"`
+	BlocklistRules              string        `json:"blocklist_rules"`
+	KeeplistRules               string        `json:"keeplist_rules"`
+	Crawler                     bool          `json:"crawler"`
+	UserAgent                   string        `json:"user_agent"`
+	Cookie                      string        `json:"cookie"`
+	Username                    string        `json:"username"`
+	Password                    string        `json:"password"`
+	Category                    *Category     `json:"category,omitempty"`
+	HideGlobally                bool          `json:"hide_globally"`
+	DisableHTTP2                bool          `json:"disable_http2"`
+	PageSelectors               PageSelectors `json:"page_selectors"`
+	Entries                     Entries       `json:"entries,omitempty"`
+}
+
+// PageSelectors contains the CSS selectors used to generate a feed from a web page.
+type PageSelectors struct {
+	Item    string `json:"item"`
+	Title   string `json:"title"`
+	Link    string `json:"link"`
+	Date    string `json:"date"`
+	Content string `json:"content"`
 }
 
 // FeedCreationRequest represents the request to create a feed.
 type FeedCreationRequest struct {
-	FeedURL                     string `json:"feed_url"`
-	CategoryID                  int64  `json:"category_id"`
-	UserAgent                   string `json:"user_agent"`
-	Cookie                      string `json:"cookie"`
-	Username                    string `json:"username"`
-	Password                    string `json:"password"`
-	Crawler                     bool   `json:"crawler"`
-	Disabled                    bool   `json:"disabled"`
-	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
-	FetchViaProxy               bool   `json:"fetch_via_proxy"`
-	ScraperRules                string `json:"scraper_rules"`
-	RewriteRules                string `json:"rewrite_rules"`
-	BlocklistRules              string `json:"blocklist_rules"`
-	KeeplistRules               string `json:"keeplist_rules"`
-	HideGlobally                bool   `json:"hide_globally"`
-	DisableHTTP2                bool   `json:"disable_http2"`
+	FeedURL                     string        `json:"feed_url"`
+	CategoryID                  int64         `json:"category_id"`
+	UserAgent                   string        `json:"user_agent"`
+	Cookie                      string        `json:"cookie"`
+	Username                    string        `json:"username"`
+	Password                    string        `json:"password"`
+	Crawler                     bool          `json:"crawler"`
+	Disabled                    bool          `json:"disabled"`
+	IgnoreHTTPCache             bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool          `json:"allow_self_signed_certificates"`
+	FetchViaProxy               bool          `json:"fetch_via_proxy"`
+	ScraperRules                string        `json:"scraper_rules"`
+	RewriteRules                string        `json:"rewrite_rules"`
+	BlocklistRules              string        `json:"blocklist_rules"`
+	KeeplistRules               string        `json:"keeplist_rules"`
+	HideGlobally                bool          `json:"hide_globally"`
+	DisableHTTP2                bool          `json:"disable_http2"`
+	PageSelectors               PageSelectors `json:"page_selectors"`
 }
 
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL                     *string `json:"feed_url"`
-	SiteURL                     *string `json:"site_url"`
-	Title                       *string `json:"title"`
-	ScraperRules                *string `json:"scraper_rules"`
-	RewriteRules                *string `json:"rewrite_rules"`
-	BlocklistRules              *string `json:"blocklist_rules"`
-	KeeplistRules               *string `json:"keeplist_rules"`
-	Crawler                     *bool   `json:"crawler"`
-	UserAgent                   *string `json:"user_agent"`
-	Cookie                      *string `json:"cookie"`
-	Username                    *string `json:"username"`
-	Password                    *string `json:"password"`
-	CategoryID                  *int64  `json:"category_id"`
-	Disabled                    *bool   `json:"disabled"`
-	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
-	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
-	HideGlobally                *bool   `json:"hide_globally"`
-	DisableHTTP2                *bool   `json:"disable_http2"`
+	FeedURL                     *string        `json:"feed_url"`
+	SiteURL                     *string        `json:"site_url"`
+	Title                       *string        `json:"title"`
+	ScraperRules                *string        `json:"scraper_rules"`
+	RewriteRules                *string        `json:"rewrite_rules"`
+	BlocklistRules              *string        `json:"blocklist_rules"`
+	KeeplistRules               *string        `json:"keeplist_rules"`
+	Crawler                     *bool          `json:"crawler"`
+	UserAgent                   *string        `json:"user_agent"`
+	Cookie                      *string        `json:"cookie"`
+	Username                    *string        `json:"username"`
+	Password                    *string        `json:"password"`
+	CategoryID                  *int64         `json:"category_id"`
+	Disabled                    *bool          `json:"disabled"`
+	IgnoreHTTPCache             *bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates *bool          `json:"allow_self_signed_certificates"`
+	FetchViaProxy               *bool          `json:"fetch_via_proxy"`
+	HideGlobally                *bool          `json:"hide_globally"`
+	DisableHTTP2                *bool          `json:"disable_http2"`
+	PageSelectors               *PageSelectors `json:"page_selectors"`
+}
+
+// FilterRulesRequest represents the request to apply the filter rules to stored entries.
+type FilterRulesRequest struct {
+	Action string `json:"action"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// FilterRulesResult reports how many stored entries have been checked and matched by the filter rules.
+type FilterRulesResult struct {
+	Action         string `json:"action"`
+	DryRun         bool   `json:"dry_run"`
+	CheckedEntries int    `json:"checked_entries"`
+	MatchedEntries int    `json:"matched_entries"`
+}
+
+// ScraperPreviewRequest represents the request to test scraper and rewrite rules on an entry of a feed.
+// The rules of the feed are used when they are nil, and the most recent entry when the entry ID is zero.
+type ScraperPreviewRequest struct {
+	EntryID      int64   `json:"entry_id,omitempty"`
+	ScraperRules *string `json:"scraper_rules,omitempty"`
+	RewriteRules *string `json:"rewrite_rules,omitempty"`
+}
+
+// ScraperPreview contains the stored content of an entry and the content produced by the previewed rules.
+type ScraperPreview struct {
+	EntryID         int64  `json:"entry_id"`
+	URL             string `json:"url"`
+	ScraperRules    string `json:"scraper_rules"`
+	RewriteRules    string `json:"rewrite_rules"`
+	OriginalContent string `json:"original_content"`
+	Content         string `json:"content"`
+}
+
+// SiteRule represents the scraper and rewrite rules of a website.
+// The instance-wide rules are managed by administrators and apply to all users.
+type SiteRule struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	InstanceWide bool      `json:"instance_wide"`
+	Domain       string    `json:"domain"`
+	ScraperRules string    `json:"scraper_rules"`
+	RewriteRules string    `json:"rewrite_rules"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// SiteRules represents a list of site rules.
+type SiteRules []*SiteRule
+
+// SiteRuleRequest represents the request to create a site rule.
+type SiteRuleRequest struct {
+	Domain       string `json:"domain"`
+	ScraperRules string `json:"scraper_rules"`
+	RewriteRules string `json:"rewrite_rules"`
+	InstanceWide bool   `json:"instance_wide"`
+}
+
+// SiteRuleModificationRequest represents the request to update a site rule.
+type SiteRuleModificationRequest struct {
+	Domain       *string `json:"domain"`
+	ScraperRules *string `json:"scraper_rules"`
+	RewriteRules *string `json:"rewrite_rules"`
 }
 
 // FeedIcon represents the feed icon.
@@ -201,30 +291,65 @@ type FeedCounters struct {
 	UnreadCounters map[int64]int `json:"unreads"`
 }
 
+// Job represents a feed refresh waiting in the background queue.
+type Job struct {
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	FeedID    int64      `json:"feed_id"`
+	Status    string     `json:"status"`
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"last_error"`
+	RunAt     time.Time  `json:"run_at"`
+	StartedAt *time.Time `json:"started_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// Jobs represents a list of jobs.
+type Jobs []*Job
+
 // Feeds represents a list of feeds.
 type Feeds []*Feed
 
+// FeedRefresh represents a refresh attempt of a feed.
+type FeedRefresh struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	FeedID     int64     `json:"feed_id"`
+	CheckedAt  time.Time `json:"checked_at"`
+	StatusCode int       `json:"status_code"`
+	Duration   int64     `json:"duration"`
+	Size       int64     `json:"size"`
+	NewEntries int       `json:"new_entries"`
+	Error      string    `json:"error"`
+	NewFeedURL string    `json:"new_feed_url"`
+}
+
+// FeedRefreshes represents the refresh history of a feed.
+type FeedRefreshes []*FeedRefresh
+
 // Entry represents a subscription item in the system.
 type Entry struct {
-	ID          int64      `json:"id"`
-	Date        time.Time  `json:"published_at"`
-	ChangedAt   time.Time  `json:"changed_at"`
-	CreatedAt   time.Time  `json:"created_at"`
-	Feed        *Feed      `json:"feed,omitempty"`
-	Hash        string     `json:"hash"`
-	URL         string     `json:"url"`
-	CommentsURL string     `json:"comments_url"`
-	Title       string     `json:"title"`
-	Status      string     `json:"status"`
-	Content     string     `json:"content"`
-	Author      string     `json:"author"`
-	ShareCode   string     `json:"share_code"`
-	Enclosures  Enclosures `json:"enclosures,omitempty"`
-	Tags        []string   `json:"tags"`
-	ReadingTime int        `json:"reading_time"`
-	UserID      int64      `json:"user_id"`
-	FeedID      int64      `json:"feed_id"`
-	Starred     bool       `json:"starred"`
+	ID            int64      `json:"id"`
+	Date          time.Time  `json:"published_at"`
+	ChangedAt     time.Time  `json:"changed_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	Feed          *Feed      `json:"feed,omitempty"`
+	Hash          string     `json:"hash"`
+	URL           string     `json:"url"`
+	CommentsURL   string     `json:"comments_url"`
+	Title         string     `json:"title"`
+	Status        string     `json:"status"`
+	Content       string     `json:"content"`
+	Author        string     `json:"author"`
+	ShareCode     string     `json:"share_code"`
+	Enclosures    Enclosures `json:"enclosures,omitempty"`
+	Tags          []string   `json:"tags"`
+	ReadingTime   int        `json:"reading_time"`
+	UserID        int64      `json:"user_id"`
+	FeedID        int64      `json:"feed_id"`
+	Starred       bool       `json:"starred"`
+	DuplicateOfID int64      `json:"duplicate_of_id,omitempty"`
+	Podcast       *Podcast   `json:"podcast,omitempty"`
 }
 
 // EntryModificationRequest represents a request to modify an entry.
@@ -238,17 +363,77 @@ type Entries []*Entry
 
 // Enclosure represents an attachment.
 type Enclosure struct {
-	ID       int64  `json:"id"`
-	UserID   int64  `json:"user_id"`
-	EntryID  int64  `json:"entry_id"`
-	URL      string `json:"url"`
-	MimeType string `json:"mime_type"`
-	Size     int    `json:"size"`
+	ID           int64  `json:"id"`
+	UserID       int64  `json:"user_id"`
+	EntryID      int64  `json:"entry_id"`
+	URL          string `json:"url"`
+	MimeType     string `json:"mime_type"`
+	Size         int    `json:"size"`
+	Duration     int    `json:"duration"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	ThumbnailURL string `json:"thumbnail_url"`
+	Title        string `json:"title"`
+	Played       bool   `json:"played"`
+}
+
+// EnclosureUpdateRequest represents a request to update the playback state of an enclosure.
+type EnclosureUpdateRequest struct {
+	MediaProgression *int64 `json:"media_progression,omitempty"`
+	Played           *bool  `json:"played,omitempty"`
+}
+
+// ListeningItem represents an audio or video enclosure of the listening queue.
+type ListeningItem struct {
+	Enclosure  *Enclosure `json:"enclosure"`
+	EntryTitle string     `json:"entry_title"`
+	EntryURL   string     `json:"entry_url"`
+	FeedID     int64      `json:"feed_id"`
+	FeedTitle  string     `json:"feed_title"`
+	Queued     bool       `json:"queued"`
+	Position   int        `json:"position"`
 }
 
+// ListeningItems represents a list of listening items.
+type ListeningItems []*ListeningItem
+
 // Enclosures represents a list of attachments.
 type Enclosures []*Enclosure
 
+// Podcast represents the Podcasting 2.0 information of an entry.
+type Podcast struct {
+	Season       string              `json:"season,omitempty"`
+	Episode      string              `json:"episode,omitempty"`
+	ChaptersURL  string              `json:"chapters_url,omitempty"`
+	ChaptersType string              `json:"chapters_type,omitempty"`
+	Transcripts  []PodcastTranscript `json:"transcripts,omitempty"`
+	Persons      []PodcastPerson     `json:"persons,omitempty"`
+	Funding      []PodcastFunding    `json:"funding,omitempty"`
+}
+
+// PodcastTranscript represents a link to the transcript of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	Type     string `json:"type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastPerson represents a person involved in an episode.
+type PodcastPerson struct {
+	Name  string `json:"name"`
+	Role  string `json:"role,omitempty"`
+	Group string `json:"group,omitempty"`
+	Image string `json:"image,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PodcastFunding represents a link to support a podcast.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+}
+
 const (
 	FilterNotStarred  = "0"
 	FilterOnlyStarred = "1"
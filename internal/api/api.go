@@ -37,6 +37,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/users/{userID:[0-9]+}", handler.updateUser).Methods(http.MethodPut)
 	sr.HandleFunc("/users/{userID:[0-9]+}", handler.removeUser).Methods(http.MethodDelete)
 	sr.HandleFunc("/users/{userID:[0-9]+}/mark-all-as-read", handler.markUserAsRead).Methods(http.MethodPut)
+	sr.HandleFunc("/users/{userID:[0-9]+}/apply-filter-rules", handler.applyUserFilterRules).Methods(http.MethodPut)
 	sr.HandleFunc("/users/{username}", handler.userByUsername).Methods(http.MethodGet)
 	sr.HandleFunc("/me", handler.currentUser).Methods(http.MethodGet)
 	sr.HandleFunc("/categories", handler.createCategory).Methods(http.MethodPost)
@@ -46,11 +47,16 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/categories/{categoryID}/mark-all-as-read", handler.markCategoryAsRead).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}/feeds", handler.getCategoryFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/categories/{categoryID}/refresh", handler.refreshCategory).Methods(http.MethodPut)
+	sr.HandleFunc("/categories/{categoryID}/apply-filter-rules", handler.applyCategoryFilterRules).Methods(http.MethodPut)
 	sr.HandleFunc("/categories/{categoryID}/entries", handler.getCategoryEntries).Methods(http.MethodGet)
 	sr.HandleFunc("/categories/{categoryID}/entries/{entryID}", handler.getCategoryEntry).Methods(http.MethodGet)
+	sr.HandleFunc("/categories/{categoryID}/feed.json", handler.getCategoryJSONFeed).Methods(http.MethodGet)
+	sr.HandleFunc("/starred/feed.json", handler.getStarredJSONFeed).Methods(http.MethodGet)
+	sr.HandleFunc("/shared/feed.json", handler.getSharedJSONFeed).Methods(http.MethodGet)
 	sr.HandleFunc("/discover", handler.discoverSubscriptions).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.createFeed).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds", handler.getFeeds).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/preview", handler.previewFeed).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds/counters", handler.fetchCounters).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}/refresh", handler.refreshFeed).Methods(http.MethodPut)
@@ -58,7 +64,10 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/feeds/{feedID}", handler.updateFeed).Methods(http.MethodPut)
 	sr.HandleFunc("/feeds/{feedID}", handler.removeFeed).Methods(http.MethodDelete)
 	sr.HandleFunc("/feeds/{feedID}/icon", handler.getIconByFeedID).Methods(http.MethodGet)
+	sr.HandleFunc("/feeds/{feedID}/history", handler.getFeedHistory).Methods(http.MethodGet)
 	sr.HandleFunc("/feeds/{feedID}/mark-all-as-read", handler.markFeedAsRead).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/{feedID}/apply-filter-rules", handler.applyFeedFilterRules).Methods(http.MethodPut)
+	sr.HandleFunc("/feeds/{feedID}/scraper-preview", handler.previewScraperRules).Methods(http.MethodPost)
 	sr.HandleFunc("/export", handler.exportFeeds).Methods(http.MethodGet)
 	sr.HandleFunc("/import", handler.importFeeds).Methods(http.MethodPost)
 	sr.HandleFunc("/feeds/{feedID}/entries", handler.getFeedEntries).Methods(http.MethodGet)
@@ -70,8 +79,23 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	sr.HandleFunc("/entries/{entryID}/bookmark", handler.toggleBookmark).Methods(http.MethodPut)
 	sr.HandleFunc("/entries/{entryID}/save", handler.saveEntry).Methods(http.MethodPost)
 	sr.HandleFunc("/entries/{entryID}/fetch-content", handler.fetchContent).Methods(http.MethodGet)
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.getEnclosureByID).Methods(http.MethodGet)
+	sr.HandleFunc("/enclosures/{enclosureID}", handler.updateEnclosureByID).Methods(http.MethodPut)
+	sr.HandleFunc("/listening-queue", handler.getListeningQueue).Methods(http.MethodGet)
+	sr.HandleFunc("/listening-queue", handler.addToListeningQueue).Methods(http.MethodPost)
+	sr.HandleFunc("/listening-queue", handler.reorderListeningQueue).Methods(http.MethodPut)
+	sr.HandleFunc("/listening-queue/{enclosureID}", handler.removeFromListeningQueue).Methods(http.MethodDelete)
+	sr.HandleFunc("/continue-listening", handler.getContinueListening).Methods(http.MethodGet)
+	sr.HandleFunc("/site-rules", handler.getSiteRules).Methods(http.MethodGet)
+	sr.HandleFunc("/site-rules", handler.createSiteRule).Methods(http.MethodPost)
+	sr.HandleFunc("/site-rules/export", handler.exportSiteRules).Methods(http.MethodGet)
+	sr.HandleFunc("/site-rules/import", handler.importSiteRules).Methods(http.MethodPost)
+	sr.HandleFunc("/site-rules/{siteRuleID:[0-9]+}", handler.getSiteRule).Methods(http.MethodGet)
+	sr.HandleFunc("/site-rules/{siteRuleID:[0-9]+}", handler.updateSiteRule).Methods(http.MethodPut)
+	sr.HandleFunc("/site-rules/{siteRuleID:[0-9]+}", handler.removeSiteRule).Methods(http.MethodDelete)
 	sr.HandleFunc("/flush-history", handler.flushHistory).Methods(http.MethodPut, http.MethodDelete)
 	sr.HandleFunc("/icons/{iconID}", handler.getIconByIconID).Methods(http.MethodGet)
+	sr.HandleFunc("/jobs", handler.getJobs).Methods(http.MethodGet)
 	sr.HandleFunc("/version", handler.versionHandler).Methods(http.MethodGet)
 }
 
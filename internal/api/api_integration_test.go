@@ -5,6 +5,7 @@ package api // import "miniflux.app/v2/internal/api"
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -1025,6 +1026,44 @@ func TestCreateFeedEndpoint(t *testing.T) {
 	}
 }
 
+func TestPreviewFeedEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	client := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	feed, err := client.PreviewFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != testConfig.testFeedTitle {
+		t.Errorf(`Invalid feed title, got %q instead of %q`, feed.Title, testConfig.testFeedTitle)
+	}
+
+	if len(feed.Entries) == 0 {
+		t.Error(`The preview should contain the feed entries`)
+	}
+}
+
+func TestPreviewFeedWithInvalidPageSelectors(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	client := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	if _, err := client.PreviewFeed(&miniflux.FeedCreationRequest{
+		FeedURL:       testConfig.testWebsiteURL,
+		PageSelectors: miniflux.PageSelectors{Item: "article["},
+	}); err == nil {
+		t.Fatal(`Invalid page selectors should be rejected`)
+	}
+}
+
 func TestCannotCreateDuplicatedFeed(t *testing.T) {
 	testConfig := newIntegrationTestConfig()
 	if !testConfig.isConfigured() {
@@ -2194,6 +2233,88 @@ func TestToggleBookmarkEndpoint(t *testing.T) {
 	}
 }
 
+func TestStarredJSONFeedEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := regularUserClient.FeedEntries(feedID, &miniflux.Filter{Limit: 1})
+	if err != nil {
+		t.Fatalf(`Failed to get entries: %v`, err)
+	}
+
+	if err := regularUserClient.ToggleBookmark(result.Entries[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := regularUserClient.StarredJSONFeed()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var jsonFeed struct {
+		Version string `json:"version"`
+		Items   []struct {
+			ID  string `json:"id"`
+			URL string `json:"url"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(data, &jsonFeed); err != nil {
+		t.Fatal(err)
+	}
+
+	if jsonFeed.Version != "https://jsonfeed.org/version/1.1" {
+		t.Errorf(`Invalid JSON Feed version, got %q`, jsonFeed.Version)
+	}
+
+	if len(jsonFeed.Items) != 1 {
+		t.Fatalf(`Invalid number of items, got %d`, len(jsonFeed.Items))
+	}
+
+	if jsonFeed.Items[0].ID != fmt.Sprint(result.Entries[0].ID) || jsonFeed.Items[0].URL != result.Entries[0].URL {
+		t.Errorf(`Invalid item, got %+v`, jsonFeed.Items[0])
+	}
+}
+
+func TestCategoryJSONFeedEndpointWithInvalidCategory(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	if _, err := regularUserClient.CategoryJSONFeed(123456789); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing category should raise a not found error, got %v`, err)
+	}
+}
+
 func TestSaveEntryEndpoint(t *testing.T) {
 	testConfig := newIntegrationTestConfig()
 	if !testConfig.isConfigured() {
@@ -2319,3 +2440,280 @@ func TestFlushHistoryEndpoint(t *testing.T) {
 		t.Fatalf(`Invalid total, got %d`, removedEntries.Total)
 	}
 }
+
+func TestListeningQueueEndpoints(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	queue, err := regularUserClient.ListeningQueue()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(queue) != 0 {
+		t.Errorf(`The listening queue of a new user should be empty, got %d items`, len(queue))
+	}
+
+	inProgress, err := regularUserClient.ContinueListening()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(inProgress) != 0 {
+		t.Errorf(`A new user should not have any enclosure in progress, got %d items`, len(inProgress))
+	}
+
+	if _, err := regularUserClient.AddToListeningQueue(123456789); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing enclosure should raise a not found error, got %v`, err)
+	}
+
+	if _, err := regularUserClient.ReorderListeningQueue(nil); err == nil {
+		t.Error(`An empty order should be rejected`)
+	}
+}
+
+func TestEnclosureEndpointsWithInvalidEnclosure(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+
+	if _, err := regularUserClient.Enclosure(123456789); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing enclosure should raise a not found error, got %v`, err)
+	}
+
+	played := true
+	if err := regularUserClient.UpdateEnclosure(123456789, &miniflux.EnclosureUpdateRequest{Played: &played}); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing enclosure should raise a not found error, got %v`, err)
+	}
+}
+
+func TestPreviewScraperRulesEndpoint(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+	feedID, err := regularUserClient.CreateFeed(&miniflux.FeedCreationRequest{
+		FeedURL: testConfig.testFeedURL,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	scraperRules := "body"
+	preview, err := regularUserClient.PreviewScraperRules(feedID, &miniflux.ScraperPreviewRequest{ScraperRules: &scraperRules})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if preview.EntryID == 0 || preview.URL == "" {
+		t.Errorf(`The preview should use the most recent entry, got %+v`, preview)
+	}
+
+	if preview.ScraperRules != scraperRules {
+		t.Errorf(`Invalid scraper rules, got %q instead of %q`, preview.ScraperRules, scraperRules)
+	}
+
+	if preview.Content == "" {
+		t.Errorf(`The preview content should not be empty`)
+	}
+
+	if _, err := regularUserClient.PreviewScraperRules(feedID, &miniflux.ScraperPreviewRequest{EntryID: 123456789}); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing entry should raise a not found error, got %v`, err)
+	}
+
+	if _, err := regularUserClient.PreviewScraperRules(123456789, &miniflux.ScraperPreviewRequest{}); err != miniflux.ErrNotFound {
+		t.Errorf(`A missing feed should raise a not found error, got %v`, err)
+	}
+}
+
+func TestSiteRulesEndpoints(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+	domain := testConfig.genRandomUsername() + ".example.org"
+
+	instanceRule, err := adminClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: domain, ScraperRules: "article", InstanceWide: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteSiteRule(instanceRule.ID)
+
+	if !instanceRule.InstanceWide || instanceRule.UserID != 0 {
+		t.Errorf(`The site rule should be instance-wide, got %+v`, instanceRule)
+	}
+
+	if _, err := regularUserClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: domain, ScraperRules: "main", InstanceWide: true}); err != miniflux.ErrForbidden {
+		t.Errorf(`Regular users should not create instance-wide rules, got %v`, err)
+	}
+
+	userRule, err := regularUserClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: "WWW." + domain, RewriteRules: "add_image_title"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if userRule.Domain != domain || userRule.UserID != regularTestUser.ID || userRule.InstanceWide {
+		t.Errorf(`Invalid site rule, got %+v`, userRule)
+	}
+
+	if _, err := regularUserClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: domain, ScraperRules: "main"}); err == nil {
+		t.Error(`Creating a second rule for the same domain should fail`)
+	}
+
+	if _, err := regularUserClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: "invalid." + domain, RewriteRules: "unknown_rule"}); err == nil {
+		t.Error(`Invalid rewrite rules should be rejected`)
+	}
+
+	siteRules, err := regularUserClient.SiteRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(siteRules) < 2 || siteRules[0].ID != userRule.ID {
+		t.Errorf(`The rules of the user should be listed before the instance-wide rules, got %+v`, siteRules)
+	}
+
+	if _, err := regularUserClient.UpdateSiteRule(instanceRule.ID, &miniflux.SiteRuleModificationRequest{}); err != miniflux.ErrForbidden {
+		t.Errorf(`Regular users should not update instance-wide rules, got %v`, err)
+	}
+
+	scraperRules := "div.content; next_page: a.next"
+	updatedRule, err := regularUserClient.UpdateSiteRule(userRule.ID, &miniflux.SiteRuleModificationRequest{ScraperRules: &scraperRules})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if updatedRule.ScraperRules != scraperRules || updatedRule.RewriteRules != "add_image_title" {
+		t.Errorf(`Invalid site rule, got %+v`, updatedRule)
+	}
+
+	if err := regularUserClient.DeleteSiteRule(instanceRule.ID); err != miniflux.ErrForbidden {
+		t.Errorf(`Regular users should not remove instance-wide rules, got %v`, err)
+	}
+
+	if err := regularUserClient.DeleteSiteRule(userRule.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := regularUserClient.SiteRule(userRule.ID); err != miniflux.ErrNotFound {
+		t.Errorf(`A removed site rule should raise a not found error, got %v`, err)
+	}
+}
+
+func TestImportAndExportSiteRulesEndpoints(t *testing.T) {
+	testConfig := newIntegrationTestConfig()
+	if !testConfig.isConfigured() {
+		t.Skip(skipIntegrationTestsMessage)
+	}
+
+	adminClient := miniflux.NewClient(testConfig.testBaseURL, testConfig.testAdminUsername, testConfig.testAdminPassword)
+	regularTestUser, err := adminClient.CreateUser(testConfig.genRandomUsername(), testConfig.testRegularPassword, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer adminClient.DeleteUser(regularTestUser.ID)
+
+	regularUserClient := miniflux.NewClient(testConfig.testBaseURL, regularTestUser.Username, testConfig.testRegularPassword)
+	if _, err := regularUserClient.CreateSiteRule(&miniflux.SiteRuleRequest{Domain: "example.org", ScraperRules: "article"}); err != nil {
+		t.Fatal(err)
+	}
+
+	rulePack := `[
+		{"domain": "example.org", "scraper_rules": "main"},
+		{"domain": "example.com", "rewrite_rules": "remove(\".ads\")"}
+	]`
+	if err := regularUserClient.ImportSiteRules(io.NopCloser(strings.NewReader(rulePack))); err != nil {
+		t.Fatal(err)
+	}
+
+	exportedRules, err := regularUserClient.ExportSiteRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var siteRules []*miniflux.SiteRuleRequest
+	if err := json.Unmarshal(exportedRules, &siteRules); err != nil {
+		t.Fatal(err)
+	}
+
+	userRules := make(map[string]*miniflux.SiteRuleRequest)
+	for _, siteRule := range siteRules {
+		if !siteRule.InstanceWide {
+			userRules[siteRule.Domain] = siteRule
+		}
+	}
+
+	if len(userRules) != 2 {
+		t.Fatalf(`Invalid number of exported rules, got %d`, len(userRules))
+	}
+
+	if userRules["example.org"].ScraperRules != "main" {
+		t.Errorf(`The imported rule should replace the existing rule of the domain, got %+v`, userRules["example.org"])
+	}
+
+	if userRules["example.com"].RewriteRules != `remove(".ads")` {
+		t.Errorf(`Invalid rewrite rules, got %+v`, userRules["example.com"])
+	}
+
+	invalidPack := `[{"domain": "example.net", "scraper_rules": "article"}, {"domain": "https://example.net/"}]`
+	if err := regularUserClient.ImportSiteRules(io.NopCloser(strings.NewReader(invalidPack))); err == nil {
+		t.Error(`An invalid rule pack should be rejected`)
+	}
+
+	instancePack := `[{"domain": "example.net", "scraper_rules": "article", "instance_wide": true}]`
+	if err := regularUserClient.ImportSiteRules(io.NopCloser(strings.NewReader(instancePack))); err != nil {
+		t.Fatal(err)
+	}
+
+	currentRules, err := regularUserClient.SiteRules()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, siteRule := range currentRules {
+		if siteRule.Domain == "example.net" && siteRule.InstanceWide {
+			t.Errorf(`The rules imported by regular users should not be instance-wide, got %+v`, siteRule)
+		}
+	}
+}
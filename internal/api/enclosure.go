@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getEnclosureByID(w http.ResponseWriter, r *http.Request) {
+	enclosure, err := h.findUserEnclosure(r)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, enclosure)
+}
+
+func (h *handler) updateEnclosureByID(w http.ResponseWriter, r *http.Request) {
+	var enclosureUpdateRequest model.EnclosureUpdateRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&enclosureUpdateRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateEnclosureModification(&enclosureUpdateRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	enclosure, err := h.findUserEnclosure(r)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	userID := request.UserID(r)
+	if enclosureUpdateRequest.MediaProgression != nil {
+		if err := h.store.UpdateEnclosureProgression(userID, enclosure.ID, *enclosureUpdateRequest.MediaProgression); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	if enclosureUpdateRequest.Played != nil {
+		if err := h.store.SetEnclosurePlayed(userID, enclosure.ID, *enclosureUpdateRequest.Played); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
+	json.NoContent(w, r)
+}
+
+// findUserEnclosure returns the enclosure of the route, or nil when it doesn't belong to the logged user.
+func (h *handler) findUserEnclosure(r *http.Request) (*model.Enclosure, error) {
+	enclosure, err := h.store.GetEnclosure(request.RouteInt64Param(r, "enclosureID"))
+	if err != nil || enclosure == nil {
+		return nil, err
+	}
+
+	if enclosure.UserID != request.UserID(r) {
+		return nil, nil
+	}
+
+	return enclosure, nil
+}
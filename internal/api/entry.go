@@ -330,7 +330,7 @@ func (h *handler) fetchContent(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if err := processor.ProcessEntryWebPage(feed, entry, user); err != nil {
+	if err := processor.ProcessEntryWebPage(h.store, feed, entry, user); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
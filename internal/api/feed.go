@@ -50,6 +50,27 @@ func (h *handler) createFeed(w http.ResponseWriter, r *http.Request) {
 	json.Created(w, r, &feedCreationResponse{FeedID: feed.ID})
 }
 
+func (h *handler) previewFeed(w http.ResponseWriter, r *http.Request) {
+	var feedCreationRequest model.FeedCreationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&feedCreationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if validationErr := validator.ValidateFeedPreview(&feedCreationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	feed, localizedError := feedHandler.PreviewFeed(&feedCreationRequest)
+	if localizedError != nil {
+		json.ServerError(w, r, localizedError.Error())
+		return
+	}
+
+	json.OK(w, r, feed)
+}
+
 func (h *handler) refreshFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
@@ -218,6 +239,24 @@ func (h *handler) getFeed(w http.ResponseWriter, r *http.Request) {
 	json.OK(w, r, feed)
 }
 
+func (h *handler) getFeedHistory(w http.ResponseWriter, r *http.Request) {
+	feedID := request.RouteInt64Param(r, "feedID")
+	userID := request.UserID(r)
+
+	if !h.store.FeedExists(userID, feedID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	refreshes, err := h.store.FeedRefreshes(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, refreshes)
+}
+
 func (h *handler) removeFeed(w http.ResponseWriter, r *http.Request) {
 	feedID := request.RouteInt64Param(r, "feedID")
 	userID := request.UserID(r)
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) applyFeedFilterRules(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	h.applyFilterRules(w, r, model.Feeds{feed})
+}
+
+func (h *handler) applyCategoryFilterRules(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	if !h.store.CategoryIDExists(userID, categoryID) {
+		json.NotFound(w, r)
+		return
+	}
+
+	feeds, err := h.store.FeedsByCategory(userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	h.applyFilterRules(w, r, feeds)
+}
+
+func (h *handler) applyUserFilterRules(w http.ResponseWriter, r *http.Request) {
+	userID := request.RouteInt64Param(r, "userID")
+	if userID != request.UserID(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	feeds, err := h.store.Feeds(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	h.applyFilterRules(w, r, feeds)
+}
+
+func (h *handler) applyFilterRules(w http.ResponseWriter, r *http.Request, feeds model.Feeds) {
+	filterRulesRequest := model.FilterRulesRequest{Action: model.FilterActionRead}
+	if r.ContentLength != 0 {
+		if err := json_parser.NewDecoder(r.Body).Decode(&filterRulesRequest); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	if validationErr := validator.ValidateFilterRulesRequest(&filterRulesRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	result, err := processor.FilterStoredEntries(h.store, user, feeds, filterRulesRequest.Action, filterRulesRequest.DryRun)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Applied filter rules to stored entries from the API",
+		slog.Int64("user_id", user.ID),
+		slog.String("action", result.Action),
+		slog.Bool("dry_run", result.DryRun),
+		slog.Int("checked_entries", result.CheckedEntries),
+		slog.Int("matched_entries", result.MatchedEntries),
+	)
+
+	json.OK(w, r, result)
+}
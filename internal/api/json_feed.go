@@ -0,0 +1,83 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/syndication"
+
+	jsonfeed "miniflux.app/v2/internal/reader/json"
+)
+
+const jsonFeedContentType = "application/feed+json; charset=utf-8"
+
+func (h *handler) getCategoryJSONFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	builder := syndication.NewEntryQueryBuilder(h.store, userID)
+	builder.WithCategoryID(categoryID)
+
+	h.writeJSONFeed(w, r, builder, category.Title, route.Path(h.router, "categoryEntries", "categoryID", categoryID), false)
+}
+
+func (h *handler) getStarredJSONFeed(w http.ResponseWriter, r *http.Request) {
+	builder := syndication.NewEntryQueryBuilder(h.store, request.UserID(r))
+	builder.WithStarred(true)
+
+	h.writeJSONFeed(w, r, builder, "Starred", route.Path(h.router, "starred"), false)
+}
+
+func (h *handler) getSharedJSONFeed(w http.ResponseWriter, r *http.Request) {
+	builder := syndication.NewEntryQueryBuilder(h.store, request.UserID(r))
+	builder.WithShareCodeNotEmpty()
+
+	h.writeJSONFeed(w, r, builder, "Shared", route.Path(h.router, "sharedEntries"), true)
+}
+
+func (h *handler) writeJSONFeed(w http.ResponseWriter, r *http.Request, builder *storage.EntryQueryBuilder, title, homePagePath string, shared bool) {
+	entries, err := syndication.FetchEntries(h.router, r, builder)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	feedURL := config.Opts.RootURL() + r.URL.Path
+	jsonFeed := jsonfeed.NewJSONFeedFromEntries(title, config.Opts.RootURL()+homePagePath, feedURL, entries)
+
+	// Shared entries link to their public page, the original article becomes the external URL.
+	if shared {
+		for i, entry := range entries {
+			jsonFeed.Items[i].ExternalURL = entry.URL
+			jsonFeed.Items[i].URL = config.Opts.RootURL() + route.Path(h.router, "sharedEntry", "shareCode", entry.ShareCode)
+		}
+	}
+
+	body, err := json_parser.Marshal(jsonFeed)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).WithHeader("Content-Type", jsonFeedContentType).WithBody(body).Write()
+}
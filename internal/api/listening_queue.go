@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+// continueListeningMaxItems is the number of partially played enclosures returned by the API.
+const continueListeningMaxItems = 50
+
+func (h *handler) getListeningQueue(w http.ResponseWriter, r *http.Request) {
+	items, err := h.store.ListeningQueue(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, items)
+}
+
+func (h *handler) addToListeningQueue(w http.ResponseWriter, r *http.Request) {
+	var queueAddRequest model.ListeningQueueAddRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&queueAddRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	enclosure, err := h.store.GetEnclosure(queueAddRequest.EnclosureID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil || enclosure.UserID != userID {
+		json.NotFound(w, r)
+		return
+	}
+
+	if err := validator.ValidateListeningQueueEnclosure(enclosure); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.AddToListeningQueue(userID, enclosure.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	items, err := h.store.ListeningQueue(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, items)
+}
+
+func (h *handler) reorderListeningQueue(w http.ResponseWriter, r *http.Request) {
+	var queueOrderRequest model.ListeningQueueOrderRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&queueOrderRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if err := validator.ValidateListeningQueueOrder(&queueOrderRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	userID := request.UserID(r)
+	if err := h.store.ReorderListeningQueue(userID, queueOrderRequest.EnclosureIDs); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	items, err := h.store.ListeningQueue(userID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, items)
+}
+
+func (h *handler) removeFromListeningQueue(w http.ResponseWriter, r *http.Request) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	if err := h.store.RemoveFromListeningQueue(request.UserID(r), enclosureID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) getContinueListening(w http.ResponseWriter, r *http.Request) {
+	items, err := h.store.ContinueListening(request.UserID(r), continueListeningMaxItems)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, items)
+}
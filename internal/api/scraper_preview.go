@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) previewScraperRules(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	feedID := request.RouteInt64Param(r, "feedID")
+
+	var previewRequest model.ScraperPreviewRequest
+	if r.ContentLength != 0 {
+		if err := json_parser.NewDecoder(r.Body).Decode(&previewRequest); err != nil {
+			json.BadRequest(w, r, err)
+			return
+		}
+	}
+
+	feed, err := h.store.FeedByID(userID, feedID)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(userID)
+	builder.WithFeedID(feedID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	if previewRequest.EntryID > 0 {
+		builder.WithEntryID(previewRequest.EntryID)
+	} else {
+		builder.WithSorting(model.DefaultSortingOrder, "desc")
+	}
+
+	entry, err := builder.GetEntry()
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if entry == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	previewRequest.Patch(feed)
+
+	if validationErr := validator.ValidateRewriteRules(feed.RewriteRules); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	content, err := processor.PreviewEntryWebPage(h.store, feed, entry)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, &model.ScraperPreview{
+		EntryID:         entry.ID,
+		URL:             entry.URL,
+		ScraperRules:    feed.ScraperRules,
+		RewriteRules:    feed.RewriteRules,
+		OriginalContent: entry.Content,
+		Content:         content,
+	})
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package api // import "miniflux.app/v2/internal/api"
+
+import (
+	json_parser "encoding/json"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/json"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) getSiteRules(w http.ResponseWriter, r *http.Request) {
+	siteRules, err := h.store.SiteRules(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, siteRules)
+}
+
+func (h *handler) getSiteRule(w http.ResponseWriter, r *http.Request) {
+	siteRule, err := h.store.SiteRule(request.UserID(r), request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if siteRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	json.OK(w, r, siteRule)
+}
+
+func (h *handler) createSiteRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var siteRuleRequest model.SiteRuleRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&siteRuleRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if siteRuleRequest.InstanceWide && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if validationErr := validator.ValidateSiteRuleCreation(h.store, userID, &siteRuleRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	siteRule, err := h.store.CreateSiteRule(userID, &siteRuleRequest)
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, siteRule)
+}
+
+func (h *handler) updateSiteRule(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+
+	var modificationRequest model.SiteRuleModificationRequest
+	if err := json_parser.NewDecoder(r.Body).Decode(&modificationRequest); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	siteRule, err := h.store.SiteRule(userID, request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if siteRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if siteRule.InstanceWide && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if validationErr := validator.ValidateSiteRuleModification(h.store, userID, siteRule, &modificationRequest); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	modificationRequest.Patch(siteRule)
+	if err := h.store.UpdateSiteRule(siteRule); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, siteRule)
+}
+
+func (h *handler) removeSiteRule(w http.ResponseWriter, r *http.Request) {
+	siteRule, err := h.store.SiteRule(request.UserID(r), request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	if siteRule == nil {
+		json.NotFound(w, r)
+		return
+	}
+
+	if siteRule.InstanceWide && !request.IsAdminUser(r) {
+		json.Forbidden(w, r)
+		return
+	}
+
+	if err := h.store.RemoveSiteRule(siteRule.ID); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.NoContent(w, r)
+}
+
+func (h *handler) exportSiteRules(w http.ResponseWriter, r *http.Request) {
+	siteRules, err := h.store.SiteRules(request.UserID(r))
+	if err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.OK(w, r, siteRules.Export())
+}
+
+func (h *handler) importSiteRules(w http.ResponseWriter, r *http.Request) {
+	var siteRuleRequests model.SiteRuleRequests
+	if err := json_parser.NewDecoder(r.Body).Decode(&siteRuleRequests); err != nil {
+		json.BadRequest(w, r, err)
+		return
+	}
+
+	if !request.IsAdminUser(r) {
+		siteRuleRequests.AsUserRules()
+	}
+
+	if validationErr := validator.ValidateSiteRuleImport(siteRuleRequests); validationErr != nil {
+		json.BadRequest(w, r, validationErr.Error())
+		return
+	}
+
+	if err := h.store.ImportSiteRules(request.UserID(r), siteRuleRequests); err != nil {
+		json.ServerError(w, r, err)
+		return
+	}
+
+	json.Created(w, r, map[string]int{"imported": len(siteRuleRequests)})
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package cli // import "miniflux.app/v2/internal/cli"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/validator"
+)
+
+func applyFilterRules(store *storage.Storage, username, action string, dryRun bool) {
+	user, err := store.UserByUsername(username)
+	if err != nil {
+		printErrorAndExit(fmt.Errorf("unable to find user: %w", err))
+	}
+
+	if user == nil {
+		printErrorAndExit(fmt.Errorf("user %q not found", username))
+	}
+
+	if validationErr := validator.ValidateFilterRulesRequest(&model.FilterRulesRequest{Action: action, DryRun: dryRun}); validationErr != nil {
+		printErrorAndExit(validationErr.Error())
+	}
+
+	feeds, err := store.Feeds(user.ID)
+	if err != nil {
+		printErrorAndExit(fmt.Errorf("unable to fetch feeds: %w", err))
+	}
+
+	result, err := processor.FilterStoredEntries(store, user, feeds, action, dryRun)
+	if err != nil {
+		printErrorAndExit(fmt.Errorf("unable to apply filter rules: %w", err))
+	}
+
+	if result.DryRun {
+		fmt.Printf("%d of %d entries match the filter rules (dry run, nothing has been changed)\n", result.MatchedEntries, result.CheckedEntries)
+	} else {
+		fmt.Printf("%d of %d entries matched the filter rules (action: %s)\n", result.MatchedEntries, result.CheckedEntries, result.Action)
+	}
+}
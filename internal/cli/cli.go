@@ -13,46 +13,53 @@ import (
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/database"
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/storage"
 	"miniflux.app/v2/internal/ui/static"
 	"miniflux.app/v2/internal/version"
 )
 
 const (
-	flagInfoHelp            = "Show build information"
-	flagVersionHelp         = "Show application version"
-	flagMigrateHelp         = "Run SQL migrations"
-	flagFlushSessionsHelp   = "Flush all sessions (disconnect users)"
-	flagCreateAdminHelp     = "Create an admin user from an interactive terminal"
-	flagResetPasswordHelp   = "Reset user password"
-	flagResetFeedErrorsHelp = "Clear all feed errors for all users"
-	flagDebugModeHelp       = "Show debug logs"
-	flagConfigFileHelp      = "Load configuration file"
-	flagConfigDumpHelp      = "Print parsed configuration values"
-	flagHealthCheckHelp     = `Perform a health check on the given endpoint (the value "auto" try to guess the health check endpoint).`
-	flagRefreshFeedsHelp    = "Refresh a batch of feeds and exit"
-	flagRunCleanupTasksHelp = "Run cleanup tasks (delete old sessions and archives old entries)"
-	flagExportUserFeedsHelp = "Export user feeds (provide the username as argument)"
+	flagInfoHelp             = "Show build information"
+	flagVersionHelp          = "Show application version"
+	flagMigrateHelp          = "Run SQL migrations"
+	flagFlushSessionsHelp    = "Flush all sessions (disconnect users)"
+	flagCreateAdminHelp      = "Create an admin user from an interactive terminal"
+	flagResetPasswordHelp    = "Reset user password"
+	flagResetFeedErrorsHelp  = "Clear all feed errors for all users"
+	flagDebugModeHelp        = "Show debug logs"
+	flagConfigFileHelp       = "Load configuration file"
+	flagConfigDumpHelp       = "Print parsed configuration values"
+	flagHealthCheckHelp      = `Perform a health check on the given endpoint (the value "auto" try to guess the health check endpoint).`
+	flagRefreshFeedsHelp     = "Refresh a batch of feeds and exit"
+	flagRunCleanupTasksHelp  = "Run cleanup tasks (delete old sessions and archives old entries)"
+	flagExportUserFeedsHelp  = "Export user feeds (provide the username as argument)"
+	flagApplyFilterRulesHelp = "Apply the current filter rules to the stored entries of a user (provide the username as argument)"
+	flagFilterActionHelp     = `Action applied to entries matching the filter rules: "read" or "remove"`
+	flagDryRunHelp           = "Only report the number of matching entries without modifying them"
 )
 
 // Parse parses command line arguments.
 func Parse() {
 	var (
-		err                 error
-		flagInfo            bool
-		flagVersion         bool
-		flagMigrate         bool
-		flagFlushSessions   bool
-		flagCreateAdmin     bool
-		flagResetPassword   bool
-		flagResetFeedErrors bool
-		flagDebugMode       bool
-		flagConfigFile      string
-		flagConfigDump      bool
-		flagHealthCheck     string
-		flagRefreshFeeds    bool
-		flagRunCleanupTasks bool
-		flagExportUserFeeds string
+		err                  error
+		flagInfo             bool
+		flagVersion          bool
+		flagMigrate          bool
+		flagFlushSessions    bool
+		flagCreateAdmin      bool
+		flagResetPassword    bool
+		flagResetFeedErrors  bool
+		flagDebugMode        bool
+		flagConfigFile       string
+		flagConfigDump       bool
+		flagHealthCheck      string
+		flagRefreshFeeds     bool
+		flagRunCleanupTasks  bool
+		flagExportUserFeeds  string
+		flagApplyFilterRules string
+		flagFilterAction     string
+		flagDryRun           bool
 	)
 
 	flag.BoolVar(&flagInfo, "info", false, flagInfoHelp)
@@ -72,6 +79,9 @@ func Parse() {
 	flag.BoolVar(&flagRefreshFeeds, "refresh-feeds", false, flagRefreshFeedsHelp)
 	flag.BoolVar(&flagRunCleanupTasks, "run-cleanup-tasks", false, flagRunCleanupTasksHelp)
 	flag.StringVar(&flagExportUserFeeds, "export-user-feeds", "", flagExportUserFeedsHelp)
+	flag.StringVar(&flagApplyFilterRules, "apply-filter-rules", "", flagApplyFilterRulesHelp)
+	flag.StringVar(&flagFilterAction, "filter-action", "read", flagFilterActionHelp)
+	flag.BoolVar(&flagDryRun, "dry-run", false, flagDryRunHelp)
 	flag.Parse()
 
 	cfg := config.NewParser()
@@ -97,6 +107,8 @@ func Parse() {
 		config.Opts.SetLogLevel("debug")
 	}
 
+	fetcher.SetHostLimits(config.Opts.HTTPClientHostRateLimit(), config.Opts.HTTPClientHostRateBurst(), config.Opts.HTTPClientHostMaxConcurrency())
+
 	logFile := config.Opts.LogFile()
 	var logFileHandler io.Writer
 	switch logFile {
@@ -225,6 +237,11 @@ func Parse() {
 		return
 	}
 
+	if flagApplyFilterRules != "" {
+		applyFilterRules(store, flagApplyFilterRules, flagFilterAction, flagDryRun)
+		return
+	}
+
 	startDaemon(store)
 }
 
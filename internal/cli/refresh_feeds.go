@@ -25,6 +25,7 @@ func refreshFeeds(store *storage.Storage) {
 	batchBuilder.WithErrorLimit(config.Opts.PollingParsingErrorLimit())
 	batchBuilder.WithoutDisabledFeeds()
 	batchBuilder.WithNextCheckExpired()
+	batchBuilder.WithLease(time.Duration(config.Opts.PollingFrequency()) * time.Minute)
 
 	jobs, err := batchBuilder.FetchJobs()
 	if err != nil {
@@ -5,13 +5,31 @@ package cli // import "miniflux.app/v2/internal/cli"
 
 import (
 	"log/slog"
+	"os"
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/websub"
 	"miniflux.app/v2/internal/worker"
 )
 
+const (
+	// cleanupLeaseName is the name of the lease held by the instance running the cleanup tasks.
+	cleanupLeaseName = "cleanup_scheduler"
+
+	// webSubLeaseName is the name of the lease held by the instance sending the WebSub subscription requests.
+	webSubLeaseName = "websub_scheduler"
+
+	webSubSchedulerInterval = 15 * time.Minute
+
+	// Subscriptions are renewed one day before the end of their lease,
+	// and requested again after one hour if the hub did not verify them.
+	webSubRenewBefore   = 24 * time.Hour
+	webSubRetryInterval = time.Hour
+)
+
 func runScheduler(store *storage.Storage, pool *worker.Pool) {
 	slog.Debug(`Starting background scheduler...`)
 
@@ -23,20 +41,31 @@ func runScheduler(store *storage.Storage, pool *worker.Pool) {
 		config.Opts.PollingParsingErrorLimit(),
 	)
 
+	holder := instanceID()
+
 	go cleanupScheduler(
 		store,
+		holder,
 		config.Opts.CleanupFrequencyHours(),
 	)
+
+	if config.Opts.WebSub() {
+		go webSubScheduler(store, holder)
+	}
 }
 
 func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSize, errorLimit int) {
-	for range time.Tick(time.Duration(frequency) * time.Minute) {
+	interval := time.Duration(frequency) * time.Minute
+
+	for range time.Tick(interval) {
 		// Generate a batch of feeds for any user that has feeds to refresh.
+		// Selected feeds are leased until the next tick, other instances pick different feeds.
 		batchBuilder := store.NewBatchBuilder()
 		batchBuilder.WithBatchSize(batchSize)
 		batchBuilder.WithErrorLimit(errorLimit)
 		batchBuilder.WithoutDisabledFeeds()
 		batchBuilder.WithNextCheckExpired()
+		batchBuilder.WithLease(interval)
 
 		if jobs, err := batchBuilder.FetchJobs(); err != nil {
 			slog.Error("Unable to fetch jobs from database", slog.Any("error", err))
@@ -49,8 +78,81 @@ func feedScheduler(store *storage.Storage, pool *worker.Pool, frequency, batchSi
 	}
 }
 
-func cleanupScheduler(store *storage.Storage, frequency int) {
-	for range time.Tick(time.Duration(frequency) * time.Hour) {
+func cleanupScheduler(store *storage.Storage, holder string, frequency int) {
+	interval := time.Duration(frequency) * time.Hour
+
+	// The leader renews its lease at each tick, the lease outlives the interval to tolerate small delays.
+	leaseDuration := interval + interval/2
+
+	for range time.Tick(interval) {
+		isLeader, err := store.AcquireLeadership(cleanupLeaseName, holder, leaseDuration)
+		if err != nil {
+			slog.Error("Unable to acquire the cleanup lease", slog.Any("error", err))
+			continue
+		}
+
+		if !isLeader {
+			slog.Debug("Skipping cleanup tasks, another instance is the leader",
+				slog.String("instance_id", holder),
+			)
+			continue
+		}
+
 		runCleanupTasks(store)
 	}
 }
+
+func webSubScheduler(store *storage.Storage, holder string) {
+	leaseDuration := webSubSchedulerInterval + webSubSchedulerInterval/2
+
+	for range time.Tick(webSubSchedulerInterval) {
+		isLeader, err := store.AcquireLeadership(webSubLeaseName, holder, leaseDuration)
+		if err != nil {
+			slog.Error("Unable to acquire the WebSub lease", slog.Any("error", err))
+			continue
+		}
+
+		if !isLeader {
+			continue
+		}
+
+		subscriptions, err := store.WebSubSubscriptionsToRenew(webSubRenewBefore, webSubRetryInterval)
+		if err != nil {
+			slog.Error("Unable to fetch WebSub subscriptions", slog.Any("error", err))
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			if err := store.MarkWebSubSubscriptionRequested(subscription.FeedID); err != nil {
+				slog.Error("Unable to update WebSub subscription", slog.Any("error", err))
+				continue
+			}
+
+			if err := websub.Subscribe(subscription); err != nil {
+				slog.Warn("Unable to subscribe to WebSub hub",
+					slog.Int64("user_id", subscription.UserID),
+					slog.Int64("feed_id", subscription.FeedID),
+					slog.String("hub_url", subscription.HubURL),
+					slog.Any("error", err),
+				)
+				continue
+			}
+
+			slog.Debug("Sent WebSub subscription request",
+				slog.Int64("user_id", subscription.UserID),
+				slog.Int64("feed_id", subscription.FeedID),
+				slog.String("hub_url", subscription.HubURL),
+				slog.String("topic_url", subscription.TopicURL),
+			)
+		}
+	}
+}
+
+// instanceID returns a unique identifier for this process, used to hold leases in the database.
+func instanceID() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "miniflux"
+	}
+	return hostname + "-" + crypto.GenerateRandomStringHex(8)
+}
@@ -725,6 +725,76 @@ func TestWorkerPoolSize(t *testing.T) {
 	}
 }
 
+func TestDefaultWorkerMaxAttemptsValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultWorkerMaxAttempts
+	result := opts.WorkerMaxAttempts()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_MAX_ATTEMPTS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWorkerMaxAttempts(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WORKER_MAX_ATTEMPTS", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 5
+	result := opts.WorkerMaxAttempts()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_MAX_ATTEMPTS value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestDefaultWorkerRetryDelayValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultWorkerRetryDelay
+	result := opts.WorkerRetryDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_RETRY_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestWorkerRetryDelay(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WORKER_RETRY_DELAY", "30")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.WorkerRetryDelay()
+
+	if result != expected {
+		t.Fatalf(`Unexpected WORKER_RETRY_DELAY value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestDefautPollingFrequencyValue(t *testing.T) {
 	os.Clearenv()
 
@@ -1023,6 +1093,41 @@ func TestPollingParsingErrorLimit(t *testing.T) {
 	}
 }
 
+func TestDefaultPermanentRedirectThresholdValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultPermanentRedirectThreshold
+	result := opts.PermanentRedirectThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PERMANENT_REDIRECT_THRESHOLD value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestPermanentRedirectThreshold(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("PERMANENT_REDIRECT_THRESHOLD", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 5
+	result := opts.PermanentRedirectThreshold()
+
+	if result != expected {
+		t.Fatalf(`Unexpected PERMANENT_REDIRECT_THRESHOLD value, got %v instead of %v`, result, expected)
+	}
+}
+
 func TestOAuth2UserCreationWhenUnset(t *testing.T) {
 	os.Clearenv()
 
@@ -1799,6 +1904,35 @@ func TestHTTPSOn(t *testing.T) {
 	}
 }
 
+func TestDefaultWebSubValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if opts.WebSub() != defaultWebSub {
+		t.Fatalf(`Unexpected WEBSUB value, got "%v"`, opts.WebSub())
+	}
+}
+
+func TestWebSub(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("WEBSUB", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	if !opts.WebSub() {
+		t.Fatalf(`Unexpected WEBSUB value, got "%v"`, opts.WebSub())
+	}
+}
+
 func TestHTTPClientTimeout(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("HTTP_CLIENT_TIMEOUT", "42")
@@ -1869,6 +2003,111 @@ func TestDefaultHTTPClientMaxBodySizeValue(t *testing.T) {
 	}
 }
 
+func TestHTTPClientHostRateLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_HOST_RATE_LIMIT", "30")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 30
+	result := opts.HTTPClientHostRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_RATE_LIMIT value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientHostRateLimitValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultHTTPClientHostRateLimit
+	result := opts.HTTPClientHostRateLimit()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_RATE_LIMIT value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestHTTPClientHostRateBurst(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_HOST_RATE_BURST", "5")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 5
+	result := opts.HTTPClientHostRateBurst()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_RATE_BURST value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientHostRateBurstValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultHTTPClientHostRateBurst
+	result := opts.HTTPClientHostRateBurst()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_RATE_BURST value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestHTTPClientHostMaxConcurrency(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("HTTP_CLIENT_HOST_MAX_CONCURRENCY", "2")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 2
+	result := opts.HTTPClientHostMaxConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_MAX_CONCURRENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
+func TestDefaultHTTPClientHostMaxConcurrencyValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultHTTPClientHostMaxConcurrency
+	result := opts.HTTPClientHostMaxConcurrency()
+
+	if result != expected {
+		t.Fatalf(`Unexpected HTTP_CLIENT_HOST_MAX_CONCURRENCY value, got %d instead of %d`, result, expected)
+	}
+}
+
 func TestHTTPServerTimeout(t *testing.T) {
 	os.Clearenv()
 	os.Setenv("HTTP_SERVER_TIMEOUT", "342")
@@ -2109,3 +2348,38 @@ func TestParseConfigDumpOutput(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestDefaultScraperMaxPagesValue(t *testing.T) {
+	os.Clearenv()
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := defaultScraperMaxPages
+	result := opts.ScraperMaxPages()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCRAPER_MAX_PAGES value, got %v instead of %v`, result, expected)
+	}
+}
+
+func TestScraperMaxPages(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCRAPER_MAX_PAGES", "1")
+
+	parser := NewParser()
+	opts, err := parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	expected := 1
+	result := opts.ScraperMaxPages()
+
+	if result != expected {
+		t.Fatalf(`Unexpected SCRAPER_MAX_PAGES value, got %v instead of %v`, result, expected)
+	}
+}
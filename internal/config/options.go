@@ -28,6 +28,8 @@ const (
 	defaultRootURL                            = "http://localhost"
 	defaultBasePath                           = ""
 	defaultWorkerPoolSize                     = 16
+	defaultWorkerMaxAttempts                  = 3
+	defaultWorkerRetryDelay                   = 60
 	defaultPollingFrequency                   = 60
 	defaultForceRefreshInterval               = 30
 	defaultBatchSize                          = 100
@@ -36,6 +38,8 @@ const (
 	defaultSchedulerEntryFrequencyMaxInterval = 24 * 60
 	defaultSchedulerEntryFrequencyFactor      = 1
 	defaultSchedulerRoundRobinMinInterval     = 60
+	defaultPermanentRedirectThreshold         = 3
+	defaultScraperMaxPages                    = 5
 	defaultPollingParsingErrorLimit           = 3
 	defaultRunMigrations                      = false
 	defaultDatabaseURL                        = "user=postgres password=postgres dbname=miniflux2 sslmode=disable"
@@ -71,6 +75,9 @@ const (
 	defaultPocketConsumerKey                  = ""
 	defaultHTTPClientTimeout                  = 20
 	defaultHTTPClientMaxBodySize              = 15
+	defaultHTTPClientHostRateLimit            = 0
+	defaultHTTPClientHostRateBurst            = 1
+	defaultHTTPClientHostMaxConcurrency       = 0
 	defaultHTTPClientProxy                    = ""
 	defaultHTTPServerTimeout                  = 300
 	defaultAuthProxyHeader                    = ""
@@ -85,6 +92,7 @@ const (
 	defaultWatchdog                           = true
 	defaultInvidiousInstance                  = "yewtu.be"
 	defaultWebAuthn                           = false
+	defaultWebSub                             = false
 )
 
 var defaultHTTPClientUserAgent = "Mozilla/5.0 (compatible; Miniflux/" + version.Version + "; +https://miniflux.app)"
@@ -132,7 +140,11 @@ type Options struct {
 	schedulerEntryFrequencyFactor      int
 	schedulerRoundRobinMinInterval     int
 	pollingParsingErrorLimit           int
+	permanentRedirectThreshold         int
+	scraperMaxPages                    int
 	workerPoolSize                     int
+	workerMaxAttempts                  int
+	workerRetryDelay                   int
 	createAdmin                        bool
 	adminUsername                      string
 	adminPassword                      string
@@ -153,6 +165,9 @@ type Options struct {
 	pocketConsumerKey                  string
 	httpClientTimeout                  int
 	httpClientMaxBodySize              int64
+	httpClientHostRateLimit            int
+	httpClientHostRateBurst            int
+	httpClientHostMaxConcurrency       int
 	httpClientProxy                    string
 	httpClientUserAgent                string
 	httpServerTimeout                  int
@@ -169,6 +184,7 @@ type Options struct {
 	invidiousInstance                  string
 	mediaProxyPrivateKey               []byte
 	webAuthn                           bool
+	webSub                             bool
 }
 
 // NewOptions returns Options with default values.
@@ -209,7 +225,11 @@ func NewOptions() *Options {
 		schedulerEntryFrequencyFactor:      defaultSchedulerEntryFrequencyFactor,
 		schedulerRoundRobinMinInterval:     defaultSchedulerRoundRobinMinInterval,
 		pollingParsingErrorLimit:           defaultPollingParsingErrorLimit,
+		permanentRedirectThreshold:         defaultPermanentRedirectThreshold,
+		scraperMaxPages:                    defaultScraperMaxPages,
 		workerPoolSize:                     defaultWorkerPoolSize,
+		workerMaxAttempts:                  defaultWorkerMaxAttempts,
+		workerRetryDelay:                   defaultWorkerRetryDelay,
 		createAdmin:                        defaultCreateAdmin,
 		mediaProxyHTTPClientTimeout:        defaultMediaProxyHTTPClientTimeout,
 		mediaProxyMode:                     defaultMediaProxyMode,
@@ -228,6 +248,9 @@ func NewOptions() *Options {
 		pocketConsumerKey:                  defaultPocketConsumerKey,
 		httpClientTimeout:                  defaultHTTPClientTimeout,
 		httpClientMaxBodySize:              defaultHTTPClientMaxBodySize * 1024 * 1024,
+		httpClientHostRateLimit:            defaultHTTPClientHostRateLimit,
+		httpClientHostRateBurst:            defaultHTTPClientHostRateBurst,
+		httpClientHostMaxConcurrency:       defaultHTTPClientHostMaxConcurrency,
 		httpClientProxy:                    defaultHTTPClientProxy,
 		httpClientUserAgent:                defaultHTTPClientUserAgent,
 		httpServerTimeout:                  defaultHTTPServerTimeout,
@@ -244,6 +267,7 @@ func NewOptions() *Options {
 		invidiousInstance:                  defaultInvidiousInstance,
 		mediaProxyPrivateKey:               crypto.GenerateRandomBytes(16),
 		webAuthn:                           defaultWebAuthn,
+		webSub:                             defaultWebSub,
 	}
 }
 
@@ -376,6 +400,16 @@ func (o *Options) WorkerPoolSize() int {
 	return o.workerPoolSize
 }
 
+// WorkerMaxAttempts returns the maximum number of attempts to refresh a feed after a transient error.
+func (o *Options) WorkerMaxAttempts() int {
+	return o.workerMaxAttempts
+}
+
+// WorkerRetryDelay returns the initial delay in seconds before retrying a failed job, doubled after each attempt.
+func (o *Options) WorkerRetryDelay() int {
+	return o.workerRetryDelay
+}
+
 // PollingFrequency returns the interval to refresh feeds in the background.
 func (o *Options) PollingFrequency() int {
 	return o.pollingFrequency
@@ -415,6 +449,16 @@ func (o *Options) SchedulerRoundRobinMinInterval() int {
 	return o.schedulerRoundRobinMinInterval
 }
 
+// PermanentRedirectThreshold returns the number of consecutive permanent redirects to the same URL before updating the feed URL, 0 means never.
+func (o *Options) PermanentRedirectThreshold() int {
+	return o.permanentRedirectThreshold
+}
+
+// ScraperMaxPages returns the maximum number of pages fetched by the scraper for a multi-page article.
+func (o *Options) ScraperMaxPages() int {
+	return o.scraperMaxPages
+}
+
 // PollingParsingErrorLimit returns the limit of errors when to stop polling.
 func (o *Options) PollingParsingErrorLimit() int {
 	return o.pollingParsingErrorLimit
@@ -545,6 +589,21 @@ func (o *Options) HTTPClientMaxBodySize() int64 {
 	return o.httpClientMaxBodySize
 }
 
+// HTTPClientHostRateLimit returns the maximum number of requests per minute sent to the same host, 0 means unlimited.
+func (o *Options) HTTPClientHostRateLimit() int {
+	return o.httpClientHostRateLimit
+}
+
+// HTTPClientHostRateBurst returns the number of requests that can be sent at once to the same host after an idle period.
+func (o *Options) HTTPClientHostRateBurst() int {
+	return o.httpClientHostRateBurst
+}
+
+// HTTPClientHostMaxConcurrency returns the maximum number of concurrent requests sent to the same host, 0 means unlimited.
+func (o *Options) HTTPClientHostMaxConcurrency() int {
+	return o.httpClientHostMaxConcurrency
+}
+
 // HTTPClientProxy returns the proxy URL for HTTP client.
 func (o *Options) HTTPClientProxy() string {
 	return o.httpClientProxy
@@ -615,6 +674,11 @@ func (o *Options) WebAuthn() bool {
 	return o.webAuthn
 }
 
+// WebSub returns true if feeds advertising a WebSub hub should receive push updates.
+func (o *Options) WebSub() bool {
+	return o.webSub
+}
+
 // FilterEntryMaxAgeDays returns the number of days after which entries should be retained.
 func (o *Options) FilterEntryMaxAgeDays() int {
 	return o.filterEntryMaxAgeDays
@@ -649,6 +713,9 @@ func (o *Options) SortedOptions(redactSecret bool) []*Option {
 		"FETCH_YOUTUBE_WATCH_TIME":               o.fetchYouTubeWatchTime,
 		"FETCH_ODYSEE_WATCH_TIME":                o.fetchOdyseeWatchTime,
 		"HTTPS":                                  o.HTTPS,
+		"HTTP_CLIENT_HOST_MAX_CONCURRENCY":       o.httpClientHostMaxConcurrency,
+		"HTTP_CLIENT_HOST_RATE_BURST":            o.httpClientHostRateBurst,
+		"HTTP_CLIENT_HOST_RATE_LIMIT":            o.httpClientHostRateLimit,
 		"HTTP_CLIENT_MAX_BODY_SIZE":              o.httpClientMaxBodySize,
 		"HTTP_CLIENT_PROXY":                      o.httpClientProxy,
 		"HTTP_CLIENT_TIMEOUT":                    o.httpClientTimeout,
@@ -676,6 +743,7 @@ func (o *Options) SortedOptions(redactSecret bool) []*Option {
 		"OAUTH2_REDIRECT_URL":                    o.oauth2RedirectURL,
 		"OAUTH2_USER_CREATION":                   o.oauth2UserCreationAllowed,
 		"POCKET_CONSUMER_KEY":                    redactSecretValue(o.pocketConsumerKey, redactSecret),
+		"PERMANENT_REDIRECT_THRESHOLD":           o.permanentRedirectThreshold,
 		"POLLING_FREQUENCY":                      o.pollingFrequency,
 		"FORCE_REFRESH_INTERVAL":                 o.forceRefreshInterval,
 		"POLLING_PARSING_ERROR_LIMIT":            o.pollingParsingErrorLimit,
@@ -692,11 +760,15 @@ func (o *Options) SortedOptions(redactSecret bool) []*Option {
 		"SCHEDULER_ENTRY_FREQUENCY_FACTOR":       o.schedulerEntryFrequencyFactor,
 		"SCHEDULER_ROUND_ROBIN_MIN_INTERVAL":     o.schedulerRoundRobinMinInterval,
 		"SCHEDULER_SERVICE":                      o.schedulerService,
+		"SCRAPER_MAX_PAGES":                      o.scraperMaxPages,
 		"SERVER_TIMING_HEADER":                   o.serverTimingHeader,
 		"WATCHDOG":                               o.watchdog,
+		"WORKER_MAX_ATTEMPTS":                    o.workerMaxAttempts,
 		"WORKER_POOL_SIZE":                       o.workerPoolSize,
+		"WORKER_RETRY_DELAY":                     o.workerRetryDelay,
 		"YOUTUBE_EMBED_URL_OVERRIDE":             o.youTubeEmbedUrlOverride,
 		"WEBAUTHN":                               o.webAuthn,
+		"WEBSUB":                                 o.webSub,
 	}
 
 	keys := make([]string, 0, len(keyValues))
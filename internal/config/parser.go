@@ -144,6 +144,10 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.cleanupRemoveSessionsDays = parseInt(value, defaultCleanupRemoveSessionsDays)
 		case "WORKER_POOL_SIZE":
 			p.opts.workerPoolSize = parseInt(value, defaultWorkerPoolSize)
+		case "WORKER_MAX_ATTEMPTS":
+			p.opts.workerMaxAttempts = parseInt(value, defaultWorkerMaxAttempts)
+		case "WORKER_RETRY_DELAY":
+			p.opts.workerRetryDelay = parseInt(value, defaultWorkerRetryDelay)
 		case "POLLING_FREQUENCY":
 			p.opts.pollingFrequency = parseInt(value, defaultPollingFrequency)
 		case "FORCE_REFRESH_INTERVAL":
@@ -162,6 +166,10 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.schedulerRoundRobinMinInterval = parseInt(value, defaultSchedulerRoundRobinMinInterval)
 		case "POLLING_PARSING_ERROR_LIMIT":
 			p.opts.pollingParsingErrorLimit = parseInt(value, defaultPollingParsingErrorLimit)
+		case "PERMANENT_REDIRECT_THRESHOLD":
+			p.opts.permanentRedirectThreshold = parseInt(value, defaultPermanentRedirectThreshold)
+		case "SCRAPER_MAX_PAGES":
+			p.opts.scraperMaxPages = parseInt(value, defaultScraperMaxPages)
 		case "PROXY_IMAGES":
 			slog.Warn("The PROXY_IMAGES environment variable is deprecated, use MEDIA_PROXY_MODE instead")
 			p.opts.mediaProxyMode = parseString(value, defaultMediaProxyMode)
@@ -231,6 +239,12 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.httpClientTimeout = parseInt(value, defaultHTTPClientTimeout)
 		case "HTTP_CLIENT_MAX_BODY_SIZE":
 			p.opts.httpClientMaxBodySize = int64(parseInt(value, defaultHTTPClientMaxBodySize) * 1024 * 1024)
+		case "HTTP_CLIENT_HOST_RATE_LIMIT":
+			p.opts.httpClientHostRateLimit = parseInt(value, defaultHTTPClientHostRateLimit)
+		case "HTTP_CLIENT_HOST_RATE_BURST":
+			p.opts.httpClientHostRateBurst = parseInt(value, defaultHTTPClientHostRateBurst)
+		case "HTTP_CLIENT_HOST_MAX_CONCURRENCY":
+			p.opts.httpClientHostMaxConcurrency = parseInt(value, defaultHTTPClientHostMaxConcurrency)
 		case "HTTP_CLIENT_PROXY":
 			p.opts.httpClientProxy = parseString(value, defaultHTTPClientProxy)
 		case "HTTP_CLIENT_USER_AGENT":
@@ -271,6 +285,8 @@ func (p *Parser) parseLines(lines []string) (err error) {
 			p.opts.invidiousInstance = parseString(value, defaultInvidiousInstance)
 		case "WEBAUTHN":
 			p.opts.webAuthn = parseBool(value, defaultWebAuthn)
+		case "WEBSUB":
+			p.opts.webSub = parseBool(value, defaultWebSub)
 		}
 	}
 
@@ -898,4 +898,200 @@ var migrations = []func(tx *sql.Tx) error{
 		_, err = tx.Exec(`DROP INDEX entries_feed_url_idx`)
 		return err
 	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users ADD COLUMN block_filter_entry_rules text not null default '';
+			ALTER TABLE users ADD COLUMN keep_filter_entry_rules text not null default '';
+			ALTER TABLE categories ADD COLUMN blocklist_rules text not null default '';
+			ALTER TABLE categories ADD COLUMN keeplist_rules text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		_, err = tx.Exec(`ALTER TABLE users ADD COLUMN entry_action_rules text not null default ''`)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE users ADD COLUMN deduplicate_entries bool not null default 'f';
+			ALTER TABLE entries ADD COLUMN canonical_url text not null default '';
+			ALTER TABLE entries ADD COLUMN duplicate_of_id bigint null references entries(id) on delete set null;
+			CREATE INDEX entries_user_canonical_url_idx ON entries(user_id, canonical_url) WHERE canonical_url <> '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE jobs (
+				id bigserial not null,
+				user_id int not null,
+				feed_id bigint not null,
+				status text not null default 'queued',
+				attempts int not null default 0,
+				last_error text not null default '',
+				run_at timestamp with time zone not null default now(),
+				started_at timestamp with time zone,
+				created_at timestamp with time zone not null default now(),
+				primary key (id),
+				unique (feed_id),
+				foreign key (user_id) references users(id) on delete cascade,
+				foreign key (feed_id) references feeds(id) on delete cascade
+			);
+			CREATE INDEX jobs_status_run_at_idx ON jobs(status, run_at);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN leased_until timestamp with time zone;
+			CREATE TABLE leader_leases (
+				name text not null,
+				holder text not null,
+				expires_at timestamp with time zone not null,
+				primary key (name)
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE websub_subscriptions (
+				feed_id bigint not null,
+				user_id int not null,
+				hub_url text not null,
+				topic_url text not null,
+				callback_token text not null,
+				secret text not null,
+				state text not null default 'pending',
+				lease_expires_at timestamp with time zone,
+				requested_at timestamp with time zone,
+				primary key (feed_id),
+				unique (callback_token),
+				foreign key (user_id) references users(id) on delete cascade,
+				foreign key (feed_id) references feeds(id) on delete cascade
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE feed_refreshes (
+				id bigserial not null,
+				user_id int not null,
+				feed_id bigint not null,
+				checked_at timestamp with time zone not null default now(),
+				status_code int not null default 0,
+				duration int not null default 0,
+				size bigint not null default 0,
+				new_entries int not null default 0,
+				error_msg text not null default '',
+				primary key (id),
+				foreign key (user_id) references users(id) on delete cascade,
+				foreign key (feed_id) references feeds(id) on delete cascade
+			);
+			CREATE INDEX feed_refreshes_feed_id_checked_at_idx ON feed_refreshes(feed_id, checked_at);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN permanent_redirect_url text not null default '';
+			ALTER TABLE feeds ADD COLUMN permanent_redirect_count int not null default 0;
+			ALTER TABLE feed_refreshes ADD COLUMN new_feed_url text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE feeds ADD COLUMN page_item_selector text not null default '';
+			ALTER TABLE feeds ADD COLUMN page_title_selector text not null default '';
+			ALTER TABLE feeds ADD COLUMN page_link_selector text not null default '';
+			ALTER TABLE feeds ADD COLUMN page_date_selector text not null default '';
+			ALTER TABLE feeds ADD COLUMN page_content_selector text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE syndication_tokens (
+				user_id int not null,
+				token text not null,
+				created_at timestamp with time zone not null default now(),
+				primary key (user_id),
+				unique (token),
+				foreign key (user_id) references users(id) on delete cascade
+			);
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `ALTER TABLE entries ADD COLUMN podcast jsonb not null default '{}'`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE enclosures ADD COLUMN duration int not null default 0;
+			ALTER TABLE enclosures ADD COLUMN width int not null default 0;
+			ALTER TABLE enclosures ADD COLUMN height int not null default 0;
+			ALTER TABLE enclosures ADD COLUMN thumbnail_url text not null default '';
+			ALTER TABLE enclosures ADD COLUMN title text not null default '';
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			ALTER TABLE enclosures ADD COLUMN played bool not null default false;
+			ALTER TABLE enclosures ADD COLUMN media_progression_updated_at timestamp with time zone;
+
+			CREATE TABLE listening_queue (
+				user_id int not null,
+				enclosure_id bigint not null,
+				position int not null default 0,
+				created_at timestamp with time zone not null default now(),
+				primary key (user_id, enclosure_id),
+				foreign key (user_id) references users(id) on delete cascade,
+				foreign key (enclosure_id) references enclosures(id) on delete cascade
+			);
+
+			CREATE INDEX enclosures_user_progression_idx ON enclosures(user_id, media_progression_updated_at) WHERE media_progression > 0 AND played = false;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `
+			CREATE TABLE site_rules (
+				id bigserial not null,
+				user_id int,
+				domain text not null,
+				scraper_rules text not null default '',
+				rewrite_rules text not null default '',
+				created_at timestamp with time zone not null default now(),
+				updated_at timestamp with time zone not null default now(),
+				primary key (id),
+				foreign key (user_id) references users(id) on delete cascade
+			);
+
+			CREATE UNIQUE INDEX site_rules_user_domain_idx ON site_rules(user_id, domain) WHERE user_id IS NOT NULL;
+			CREATE UNIQUE INDEX site_rules_instance_domain_idx ON site_rules(domain) WHERE user_id IS NULL;
+		`
+		_, err = tx.Exec(sql)
+		return err
+	},
+	func(tx *sql.Tx) (err error) {
+		sql := `ALTER TABLE jobs ADD COLUMN payload bytea`
+		_, err = tx.Exec(sql)
+		return err
+	},
 }
@@ -32,6 +32,15 @@ func Created(w http.ResponseWriter, r *http.Request, body interface{}) {
 	builder.Write()
 }
 
+// Attachment forces the JSON document to be downloaded by the web browser.
+func Attachment(w http.ResponseWriter, r *http.Request, filename string, body interface{}) {
+	builder := response.New(w, r)
+	builder.WithHeader("Content-Type", contentTypeHeader)
+	builder.WithAttachment(filename)
+	builder.WithBody(toJSON(body))
+	builder.Write()
+}
+
 // NoContent sends a no content response to the client.
 func NoContent(w http.ResponseWriter, r *http.Request) {
 	builder := response.New(w, r)
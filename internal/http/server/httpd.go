@@ -20,8 +20,10 @@ import (
 	"miniflux.app/v2/internal/googlereader"
 	"miniflux.app/v2/internal/http/request"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/syndication"
 	"miniflux.app/v2/internal/ui"
 	"miniflux.app/v2/internal/version"
+	"miniflux.app/v2/internal/websub"
 	"miniflux.app/v2/internal/worker"
 
 	"github.com/gorilla/mux"
@@ -192,6 +194,12 @@ func setupHandler(store *storage.Storage, pool *worker.Pool) *mux.Router {
 	fever.Serve(router, store)
 	googlereader.Serve(router, store)
 	api.Serve(router, store, pool)
+	syndication.Serve(router, store)
+
+	if config.Opts.WebSub() {
+		websub.Serve(router, store, pool)
+	}
+
 	ui.Serve(router, store, pool)
 
 	router.HandleFunc("/healthcheck", func(w http.ResponseWriter, r *http.Request) {
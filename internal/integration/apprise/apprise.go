@@ -27,11 +27,15 @@ func NewClient(serviceURL, baseURL string) *Client {
 }
 
 func (c *Client) SendNotification(entry *model.Entry) error {
+	return c.SendMessage("[" + entry.Title + "]" + "(" + entry.URL + ")" + "\n\n")
+}
+
+// SendMessage sends a free-form markdown message to the Apprise services.
+func (c *Client) SendMessage(message string) error {
 	if c.baseURL == "" || c.servicesURL == "" {
 		return fmt.Errorf("apprise: missing base URL or services URL")
 	}
 
-	message := "[" + entry.Title + "]" + "(" + entry.URL + ")" + "\n\n"
 	apiEndpoint, err := urllib.JoinBaseURLAndPath(c.baseURL, "/notify")
 	if err != nil {
 		return fmt.Errorf(`apprise: invalid API endpoint: %v`, err)
@@ -4,6 +4,8 @@
 package integration // import "miniflux.app/v2/internal/integration"
 
 import (
+	"fmt"
+	"html"
 	"log/slog"
 
 	"miniflux.app/v2/internal/config"
@@ -485,3 +487,141 @@ func PushEntries(feed *model.Feed, entries model.Entries, userIntegrations *mode
 		}
 	}
 }
+
+// NotifyFeedDisabled informs the user through the notification integrations that a feed has been disabled automatically.
+func NotifyFeedDisabled(feed *model.Feed, reason string, userIntegrations *model.Integration) {
+	textMessage := fmt.Sprintf("The feed %q (%s) has been disabled: %s", feed.Title, feed.FeedURL, reason)
+
+	if userIntegrations.MatrixBotEnabled {
+		slog.Debug("Sending feed disabled notification to Matrix",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("feed_id", feed.ID),
+		)
+
+		formattedMessage := fmt.Sprintf(`The feed <a href=%q>%s</a> has been disabled: %s`, feed.FeedURL, html.EscapeString(feed.Title), html.EscapeString(reason))
+		if err := matrixbot.PushMessage(
+			textMessage,
+			formattedMessage,
+			userIntegrations.MatrixBotURL,
+			userIntegrations.MatrixBotUser,
+			userIntegrations.MatrixBotPassword,
+			userIntegrations.MatrixBotChatID,
+		); err != nil {
+			slog.Error("Unable to send feed disabled notification to Matrix",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("feed_id", feed.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if userIntegrations.WebhookEnabled {
+		slog.Debug("Sending feed disabled event to Webhook",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("webhook_url", userIntegrations.WebhookURL),
+		)
+
+		webhookClient := webhook.NewClient(userIntegrations.WebhookURL, userIntegrations.WebhookSecret)
+		if err := webhookClient.SendFeedDisabledWebhookEvent(feed, reason); err != nil {
+			slog.Debug("Unable to send feed disabled event to Webhook",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("feed_id", feed.ID),
+				slog.String("webhook_url", userIntegrations.WebhookURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if userIntegrations.TelegramBotEnabled {
+		slog.Debug("Sending feed disabled notification to Telegram",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("feed_id", feed.ID),
+		)
+
+		formattedText := fmt.Sprintf(`The feed <a href=%q>%s</a> has been disabled: %s`, feed.FeedURL, html.EscapeString(feed.Title), html.EscapeString(reason))
+		if err := telegrambot.PushMessage(
+			formattedText,
+			userIntegrations.TelegramBotToken,
+			userIntegrations.TelegramBotChatID,
+			userIntegrations.TelegramBotTopicID,
+			userIntegrations.TelegramBotDisableNotification,
+		); err != nil {
+			slog.Error("Unable to send feed disabled notification to Telegram",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("feed_id", feed.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if userIntegrations.AppriseEnabled {
+		slog.Debug("Sending feed disabled notification to Apprise",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("apprise_url", userIntegrations.AppriseURL),
+		)
+
+		appriseServiceURLs := userIntegrations.AppriseServicesURL
+		if feed.AppriseServiceURLs != "" {
+			appriseServiceURLs = feed.AppriseServiceURLs
+		}
+
+		client := apprise.NewClient(appriseServiceURLs, userIntegrations.AppriseURL)
+		if err := client.SendMessage(textMessage); err != nil {
+			slog.Error("Unable to send feed disabled notification to Apprise",
+				slog.Int64("user_id", userIntegrations.UserID),
+				slog.Int64("feed_id", feed.ID),
+				slog.String("apprise_url", userIntegrations.AppriseURL),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// savingIntegrations maps the name of each integration used by SendEntry to its "enabled" setting.
+var savingIntegrations = map[string]func(*model.Integration) *bool{
+	"espial":      func(i *model.Integration) *bool { return &i.EspialEnabled },
+	"instapaper":  func(i *model.Integration) *bool { return &i.InstapaperEnabled },
+	"linkace":     func(i *model.Integration) *bool { return &i.LinkAceEnabled },
+	"linkding":    func(i *model.Integration) *bool { return &i.LinkdingEnabled },
+	"linkwarden":  func(i *model.Integration) *bool { return &i.LinkwardenEnabled },
+	"notion":      func(i *model.Integration) *bool { return &i.NotionEnabled },
+	"nunuxkeeper": func(i *model.Integration) *bool { return &i.NunuxKeeperEnabled },
+	"omnivore":    func(i *model.Integration) *bool { return &i.OmnivoreEnabled },
+	"pinboard":    func(i *model.Integration) *bool { return &i.PinboardEnabled },
+	"pocket":      func(i *model.Integration) *bool { return &i.PocketEnabled },
+	"readeck":     func(i *model.Integration) *bool { return &i.ReadeckEnabled },
+	"readwise":    func(i *model.Integration) *bool { return &i.ReadwiseEnabled },
+	"shaarli":     func(i *model.Integration) *bool { return &i.ShaarliEnabled },
+	"shiori":      func(i *model.Integration) *bool { return &i.ShioriEnabled },
+	"wallabag":    func(i *model.Integration) *bool { return &i.WallabagEnabled },
+	"webhook":     func(i *model.Integration) *bool { return &i.WebhookEnabled },
+}
+
+// IsSavingIntegration returns true if the entries can be sent to the given integration with SendEntry.
+func IsSavingIntegration(name string) bool {
+	_, found := savingIntegrations[name]
+	return found
+}
+
+// SendEntryToIntegration sends the entry to a single third-party provider, if enabled by the user.
+func SendEntryToIntegration(entry *model.Entry, userIntegrations *model.Integration, name string) {
+	enabled, found := savingIntegrations[name]
+	if !found || !*enabled(userIntegrations) {
+		slog.Debug("Integration not available for this user",
+			slog.Int64("user_id", userIntegrations.UserID),
+			slog.Int64("entry_id", entry.ID),
+			slog.String("integration", name),
+		)
+		return
+	}
+
+	selectedIntegration := *userIntegrations
+	for _, enabled := range savingIntegrations {
+		*enabled(&selectedIntegration) = false
+	}
+	*enabled(&selectedIntegration) = true
+
+	SendEntry(entry, &selectedIntegration)
+}
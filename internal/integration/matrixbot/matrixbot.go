@@ -12,6 +12,26 @@ import (
 
 // PushEntry pushes entries to matrix chat using integration settings provided
 func PushEntries(feed *model.Feed, entries model.Entries, matrixBaseURL, matrixUsername, matrixPassword, matrixRoomID string) error {
+	var textMessages []string
+	var formattedTextMessages []string
+
+	for _, entry := range entries {
+		textMessages = append(textMessages, fmt.Sprintf(`[%s] %s - %s`, feed.Title, entry.Title, entry.URL))
+		formattedTextMessages = append(formattedTextMessages, fmt.Sprintf(`<li><strong>%s</strong>: <a href=%q>%s</a></li>`, feed.Title, entry.URL, entry.Title))
+	}
+
+	return PushMessage(
+		strings.Join(textMessages, "\n"),
+		"<ul>"+strings.Join(formattedTextMessages, "\n")+"</ul>",
+		matrixBaseURL,
+		matrixUsername,
+		matrixPassword,
+		matrixRoomID,
+	)
+}
+
+// PushMessage sends a text message and its HTML version to the matrix chat.
+func PushMessage(textMessage, formattedMessage, matrixBaseURL, matrixUsername, matrixPassword, matrixRoomID string) error {
 	client := NewClient(matrixBaseURL)
 	discovery, err := client.DiscoverEndpoints()
 	if err != nil {
@@ -23,20 +43,12 @@ func PushEntries(feed *model.Feed, entries model.Entries, matrixBaseURL, matrixU
 		return err
 	}
 
-	var textMessages []string
-	var formattedTextMessages []string
-
-	for _, entry := range entries {
-		textMessages = append(textMessages, fmt.Sprintf(`[%s] %s - %s`, feed.Title, entry.Title, entry.URL))
-		formattedTextMessages = append(formattedTextMessages, fmt.Sprintf(`<li><strong>%s</strong>: <a href=%q>%s</a></li>`, feed.Title, entry.URL, entry.Title))
-	}
-
 	_, err = client.SendFormattedTextMessage(
 		discovery.HomeServerInformation.BaseURL,
 		loginResponse.AccessToken,
 		matrixRoomID,
-		strings.Join(textMessages, "\n"),
-		"<ul>"+strings.Join(formattedTextMessages, "\n")+"</ul>",
+		textMessage,
+		formattedMessage,
 	)
 
 	return err
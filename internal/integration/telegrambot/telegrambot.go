@@ -50,3 +50,22 @@ func PushEntry(feed *model.Feed, entry *model.Entry, botToken, chatID string, to
 	_, err := client.SendMessage(message)
 	return err
 }
+
+// PushMessage sends a HTML formatted text message to the chat.
+func PushMessage(formattedText, botToken, chatID string, topicID *int64, disableNotification bool) error {
+	message := &MessageRequest{
+		ChatID:                chatID,
+		Text:                  formattedText,
+		ParseMode:             HTMLFormatting,
+		DisableWebPagePreview: true,
+		DisableNotification:   disableNotification,
+	}
+
+	if topicID != nil {
+		message.MessageThreadID = *topicID
+	}
+
+	client := NewClient(botToken, chatID)
+	_, err := client.SendMessage(message)
+	return err
+}
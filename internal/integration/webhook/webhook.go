@@ -18,8 +18,9 @@ import (
 const (
 	defaultClientTimeout = 10 * time.Second
 
-	NewEntriesEventType = "new_entries"
-	SaveEntryEventType  = "save_entry"
+	NewEntriesEventType   = "new_entries"
+	SaveEntryEventType    = "save_entry"
+	FeedDisabledEventType = "feed_disabled"
 )
 
 type Client struct {
@@ -111,6 +112,23 @@ func (c *Client) SendNewEntriesWebhookEvent(feed *model.Feed, entries model.Entr
 	})
 }
 
+func (c *Client) SendFeedDisabledWebhookEvent(feed *model.Feed, reason string) error {
+	return c.makeRequest(FeedDisabledEventType, &WebhookFeedDisabledEvent{
+		EventType: FeedDisabledEventType,
+		Feed: &WebhookFeed{
+			ID:         feed.ID,
+			UserID:     feed.UserID,
+			CategoryID: feed.Category.ID,
+			Category:   &WebhookCategory{ID: feed.Category.ID, Title: feed.Category.Title},
+			FeedURL:    feed.FeedURL,
+			SiteURL:    feed.SiteURL,
+			Title:      feed.Title,
+			CheckedAt:  feed.CheckedAt,
+		},
+		Reason: reason,
+	})
+}
+
 func (c *Client) makeRequest(eventType string, payload any) error {
 	if c.webhookURL == "" {
 		return fmt.Errorf(`webhook: missing webhook URL`)
@@ -193,3 +211,9 @@ type WebhookSaveEntryEvent struct {
 	EventType string        `json:"event_type"`
 	Entry     *WebhookEntry `json:"entry"`
 }
+
+type WebhookFeedDisabledEvent struct {
+	EventType string       `json:"event_type"`
+	Feed      *WebhookFeed `json:"feed"`
+	Reason    string       `json:"reason"`
+}
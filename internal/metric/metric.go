@@ -78,6 +78,15 @@ var (
 		[]string{"status"},
 	)
 
+	jobsGauge = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "miniflux",
+			Name:      "jobs",
+			Help:      "Number of feed refresh jobs by status",
+		},
+		[]string{"status"},
+	)
+
 	dbOpenConnectionsGauge = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "miniflux",
@@ -150,6 +159,7 @@ func NewCollector(store *storage.Storage, refreshInterval int) *Collector {
 	prometheus.MustRegister(feedsGauge)
 	prometheus.MustRegister(brokenFeedsGauge)
 	prometheus.MustRegister(entriesGauge)
+	prometheus.MustRegister(jobsGauge)
 	prometheus.MustRegister(dbOpenConnectionsGauge)
 	prometheus.MustRegister(dbConnectionsInUseGauge)
 	prometheus.MustRegister(dbConnectionsIdleGauge)
@@ -179,6 +189,11 @@ func (c *Collector) GatherStorageMetrics() {
 			entriesGauge.WithLabelValues(status).Set(float64(count))
 		}
 
+		jobsCount := c.store.CountAllJobs()
+		for status, count := range jobsCount {
+			jobsGauge.WithLabelValues(status).Set(float64(count))
+		}
+
 		dbStats := c.store.DBStats()
 		dbOpenConnectionsGauge.Set(float64(dbStats.OpenConnections))
 		dbConnectionsInUseGauge.Set(float64(dbStats.InUse))
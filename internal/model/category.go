@@ -7,12 +7,14 @@ import "fmt"
 
 // Category represents a feed category.
 type Category struct {
-	ID           int64  `json:"id"`
-	Title        string `json:"title"`
-	UserID       int64  `json:"user_id"`
-	HideGlobally bool   `json:"hide_globally"`
-	FeedCount    *int   `json:"feed_count,omitempty"`
-	TotalUnread  *int   `json:"total_unread,omitempty"`
+	ID             int64  `json:"id"`
+	Title          string `json:"title"`
+	UserID         int64  `json:"user_id"`
+	HideGlobally   bool   `json:"hide_globally"`
+	BlocklistRules string `json:"blocklist_rules"`
+	KeeplistRules  string `json:"keeplist_rules"`
+	FeedCount      *int   `json:"feed_count,omitempty"`
+	TotalUnread    *int   `json:"total_unread,omitempty"`
 }
 
 func (c *Category) String() string {
@@ -21,14 +23,24 @@ func (c *Category) String() string {
 
 // CategoryRequest represents the request to create or update a category.
 type CategoryRequest struct {
-	Title        string `json:"title"`
-	HideGlobally string `json:"hide_globally"`
+	Title          string  `json:"title"`
+	HideGlobally   string  `json:"hide_globally"`
+	BlocklistRules *string `json:"blocklist_rules"`
+	KeeplistRules  *string `json:"keeplist_rules"`
 }
 
 // Patch updates category fields.
 func (cr *CategoryRequest) Patch(category *Category) {
 	category.Title = cr.Title
 	category.HideGlobally = cr.HideGlobally != ""
+
+	if cr.BlocklistRules != nil {
+		category.BlocklistRules = *cr.BlocklistRules
+	}
+
+	if cr.KeeplistRules != nil {
+		category.KeeplistRules = *cr.KeeplistRules
+	}
 }
 
 // Categories represents a list of categories.
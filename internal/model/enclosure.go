@@ -3,6 +3,8 @@
 
 package model // import "miniflux.app/v2/internal/model"
 
+import "strings"
+
 // Enclosure represents an attachment.
 type Enclosure struct {
 	ID               int64  `json:"id"`
@@ -12,6 +14,12 @@ type Enclosure struct {
 	MimeType         string `json:"mime_type"`
 	Size             int64  `json:"size"`
 	MediaProgression int64  `json:"media_progression"`
+	Duration         int    `json:"duration"` // In seconds.
+	Width            int    `json:"width"`
+	Height           int    `json:"height"`
+	ThumbnailURL     string `json:"thumbnail_url"`
+	Title            string `json:"title"`
+	Played           bool   `json:"played"`
 }
 
 // Html5MimeType will modify the actual MimeType to allow direct playback from HTML5 player for some kind of MimeType
@@ -22,5 +30,16 @@ func (e Enclosure) Html5MimeType() string {
 	return e.MimeType
 }
 
+// IsPlayable returns true if the enclosure can be played by the audio or video player.
+func (e Enclosure) IsPlayable() bool {
+	return strings.HasPrefix(e.MimeType, "audio/") || strings.HasPrefix(e.MimeType, "video/")
+}
+
 // EnclosureList represents a list of attachments.
 type EnclosureList []*Enclosure
+
+// EnclosureUpdateRequest represents a request to update the playback state of an enclosure.
+type EnclosureUpdateRequest struct {
+	MediaProgression *int64 `json:"media_progression"`
+	Played           *bool  `json:"played"`
+}
@@ -23,36 +23,39 @@ const (
 
 // Feed represents a feed in the application.
 type Feed struct {
-	ID                          int64     `json:"id"`
-	UserID                      int64     `json:"user_id"`
-	FeedURL                     string    `json:"feed_url"`
-	SiteURL                     string    `json:"site_url"`
-	Title                       string    `json:"title"`
-	CheckedAt                   time.Time `json:"checked_at"`
-	NextCheckAt                 time.Time `json:"next_check_at"`
-	EtagHeader                  string    `json:"etag_header"`
-	LastModifiedHeader          string    `json:"last_modified_header"`
-	ParsingErrorMsg             string    `json:"parsing_error_message"`
-	ParsingErrorCount           int       `json:"parsing_error_count"`
-	ScraperRules                string    `json:"scraper_rules"`
-	RewriteRules                string    `json:"rewrite_rules"`
-	Crawler                     bool      `json:"crawler"`
-	BlocklistRules              string    `json:"blocklist_rules"`
-	KeeplistRules               string    `json:"keeplist_rules"`
-	UrlRewriteRules             string    `json:"urlrewrite_rules"`
-	UserAgent                   string    `json:"user_agent"`
-	Cookie                      string    `json:"cookie"`
-	Username                    string    `json:"username"`
-	Password                    string    `json:"password"`
-	Disabled                    bool      `json:"disabled"`
-	NoMediaPlayer               bool      `json:"no_media_player"`
-	IgnoreHTTPCache             bool      `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool      `json:"allow_self_signed_certificates"`
-	ApplyFilterToContent        bool      `json:"apply_filter_to_content"`
-	FetchViaProxy               bool      `json:"fetch_via_proxy"`
-	HideGlobally                bool      `json:"hide_globally"`
-	AppriseServiceURLs          string    `json:"apprise_service_urls"`
-	DisableHTTP2                bool      `json:"disable_http2"`
+	ID                          int64         `json:"id"`
+	UserID                      int64         `json:"user_id"`
+	FeedURL                     string        `json:"feed_url"`
+	SiteURL                     string        `json:"site_url"`
+	Title                       string        `json:"title"`
+	CheckedAt                   time.Time     `json:"checked_at"`
+	NextCheckAt                 time.Time     `json:"next_check_at"`
+	EtagHeader                  string        `json:"etag_header"`
+	LastModifiedHeader          string        `json:"last_modified_header"`
+	ParsingErrorMsg             string        `json:"parsing_error_message"`
+	ParsingErrorCount           int           `json:"parsing_error_count"`
+	ScraperRules                string        `json:"scraper_rules"`
+	RewriteRules                string        `json:"rewrite_rules"`
+	Crawler                     bool          `json:"crawler"`
+	BlocklistRules              string        `json:"blocklist_rules"`
+	KeeplistRules               string        `json:"keeplist_rules"`
+	UrlRewriteRules             string        `json:"urlrewrite_rules"`
+	UserAgent                   string        `json:"user_agent"`
+	Cookie                      string        `json:"cookie"`
+	Username                    string        `json:"username"`
+	Password                    string        `json:"password"`
+	Disabled                    bool          `json:"disabled"`
+	NoMediaPlayer               bool          `json:"no_media_player"`
+	IgnoreHTTPCache             bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool          `json:"allow_self_signed_certificates"`
+	ApplyFilterToContent        bool          `json:"apply_filter_to_content"`
+	FetchViaProxy               bool          `json:"fetch_via_proxy"`
+	HideGlobally                bool          `json:"hide_globally"`
+	AppriseServiceURLs          string        `json:"apprise_service_urls"`
+	DisableHTTP2                bool          `json:"disable_http2"`
+	PageSelectors               PageSelectors `json:"page_selectors"`
+	PermanentRedirectURL        string        `json:"-"`
+	PermanentRedirectCount      int           `json:"-"`
 
 	// Non persisted attributes
 	Category *Category `json:"category,omitempty"`
@@ -61,6 +64,7 @@ type Feed struct {
 
 	TTL                    int    `json:"-"`
 	IconURL                string `json:"-"`
+	HubURL                 string `json:"-"`
 	UnreadCount            int    `json:"-"`
 	ReadCount              int    `json:"-"`
 	NumberOfVisibleEntries int    `json:"-"`
@@ -109,7 +113,10 @@ func (f *Feed) CheckedNow() {
 }
 
 // ScheduleNextCheck set "next_check_at" of a feed based on the scheduler selected from the configuration.
-func (f *Feed) ScheduleNextCheck(weeklyCount int, newTTL int) {
+//
+// The cache max age, in minutes, comes from the Cache-Control and Expires headers of the last response:
+// it can only postpone the next check, up to the entry frequency max interval.
+func (f *Feed) ScheduleNextCheck(weeklyCount int, newTTL int, cacheMaxAge int) {
 	f.TTL = newTTL
 	// Default to the global config Polling Frequency.
 	var intervalMinutes int
@@ -125,6 +132,10 @@ func (f *Feed) ScheduleNextCheck(weeklyCount int, newTTL int) {
 	default:
 		intervalMinutes = config.Opts.SchedulerRoundRobinMinInterval()
 	}
+	// If the publisher tells us the feed stays fresh longer, we poll less aggressively.
+	if cacheMaxAge > intervalMinutes {
+		intervalMinutes = max(intervalMinutes, min(cacheMaxAge, config.Opts.SchedulerEntryFrequencyMaxInterval()))
+	}
 	// If the feed has a TTL defined, we use it to make sure we don't check it too often.
 	if newTTL > intervalMinutes && newTTL > 0 {
 		intervalMinutes = newTTL
@@ -132,28 +143,56 @@ func (f *Feed) ScheduleNextCheck(weeklyCount int, newTTL int) {
 	f.NextCheckAt = time.Now().Add(time.Minute * time.Duration(intervalMinutes))
 }
 
+// TrackPermanentRedirect counts the consecutive refreshes permanently redirected to the same URL and returns the count.
+func (f *Feed) TrackPermanentRedirect(redirectURL string) int {
+	switch {
+	case redirectURL == "":
+		f.PermanentRedirectURL = ""
+		f.PermanentRedirectCount = 0
+	case redirectURL == f.PermanentRedirectURL:
+		f.PermanentRedirectCount++
+	default:
+		f.PermanentRedirectURL = redirectURL
+		f.PermanentRedirectCount = 1
+	}
+	return f.PermanentRedirectCount
+}
+
+// PostponeNextCheck delays "next_check_at" when the server asks to retry later, without exceeding the maximum interval.
+func (f *Feed) PostponeNextCheck(delay time.Duration) {
+	maxDelay := time.Minute * time.Duration(config.Opts.SchedulerEntryFrequencyMaxInterval())
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	if nextCheckAt := time.Now().Add(delay); nextCheckAt.After(f.NextCheckAt) {
+		f.NextCheckAt = nextCheckAt
+	}
+}
+
 // FeedCreationRequest represents the request to create a feed.
 type FeedCreationRequest struct {
-	FeedURL                     string `json:"feed_url"`
-	CategoryID                  int64  `json:"category_id"`
-	UserAgent                   string `json:"user_agent"`
-	Cookie                      string `json:"cookie"`
-	Username                    string `json:"username"`
-	Password                    string `json:"password"`
-	Crawler                     bool   `json:"crawler"`
-	Disabled                    bool   `json:"disabled"`
-	NoMediaPlayer               bool   `json:"no_media_player"`
-	IgnoreHTTPCache             bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates bool   `json:"allow_self_signed_certificates"`
-	ApplyFilterToContent        bool   `json:"apply_filter_to_content"`
-	FetchViaProxy               bool   `json:"fetch_via_proxy"`
-	ScraperRules                string `json:"scraper_rules"`
-	RewriteRules                string `json:"rewrite_rules"`
-	BlocklistRules              string `json:"blocklist_rules"`
-	KeeplistRules               string `json:"keeplist_rules"`
-	HideGlobally                bool   `json:"hide_globally"`
-	UrlRewriteRules             string `json:"urlrewrite_rules"`
-	DisableHTTP2                bool   `json:"disable_http2"`
+	FeedURL                     string        `json:"feed_url"`
+	CategoryID                  int64         `json:"category_id"`
+	UserAgent                   string        `json:"user_agent"`
+	Cookie                      string        `json:"cookie"`
+	Username                    string        `json:"username"`
+	Password                    string        `json:"password"`
+	Crawler                     bool          `json:"crawler"`
+	Disabled                    bool          `json:"disabled"`
+	NoMediaPlayer               bool          `json:"no_media_player"`
+	IgnoreHTTPCache             bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates bool          `json:"allow_self_signed_certificates"`
+	ApplyFilterToContent        bool          `json:"apply_filter_to_content"`
+	FetchViaProxy               bool          `json:"fetch_via_proxy"`
+	ScraperRules                string        `json:"scraper_rules"`
+	RewriteRules                string        `json:"rewrite_rules"`
+	BlocklistRules              string        `json:"blocklist_rules"`
+	KeeplistRules               string        `json:"keeplist_rules"`
+	HideGlobally                bool          `json:"hide_globally"`
+	UrlRewriteRules             string        `json:"urlrewrite_rules"`
+	DisableHTTP2                bool          `json:"disable_http2"`
+	PageSelectors               PageSelectors `json:"page_selectors"`
 }
 
 type FeedCreationRequestFromSubscriptionDiscovery struct {
@@ -166,28 +205,29 @@ type FeedCreationRequestFromSubscriptionDiscovery struct {
 
 // FeedModificationRequest represents the request to update a feed.
 type FeedModificationRequest struct {
-	FeedURL                     *string `json:"feed_url"`
-	SiteURL                     *string `json:"site_url"`
-	Title                       *string `json:"title"`
-	ScraperRules                *string `json:"scraper_rules"`
-	RewriteRules                *string `json:"rewrite_rules"`
-	BlocklistRules              *string `json:"blocklist_rules"`
-	KeeplistRules               *string `json:"keeplist_rules"`
-	UrlRewriteRules             *string `json:"urlrewrite_rules"`
-	Crawler                     *bool   `json:"crawler"`
-	UserAgent                   *string `json:"user_agent"`
-	Cookie                      *string `json:"cookie"`
-	Username                    *string `json:"username"`
-	Password                    *string `json:"password"`
-	CategoryID                  *int64  `json:"category_id"`
-	Disabled                    *bool   `json:"disabled"`
-	NoMediaPlayer               *bool   `json:"no_media_player"`
-	IgnoreHTTPCache             *bool   `json:"ignore_http_cache"`
-	AllowSelfSignedCertificates *bool   `json:"allow_self_signed_certificates"`
-	ApplyFilterToContent        *bool   `json:"apply_filter_to_content"`
-	FetchViaProxy               *bool   `json:"fetch_via_proxy"`
-	HideGlobally                *bool   `json:"hide_globally"`
-	DisableHTTP2                *bool   `json:"disable_http2"`
+	FeedURL                     *string        `json:"feed_url"`
+	SiteURL                     *string        `json:"site_url"`
+	Title                       *string        `json:"title"`
+	ScraperRules                *string        `json:"scraper_rules"`
+	RewriteRules                *string        `json:"rewrite_rules"`
+	BlocklistRules              *string        `json:"blocklist_rules"`
+	KeeplistRules               *string        `json:"keeplist_rules"`
+	UrlRewriteRules             *string        `json:"urlrewrite_rules"`
+	Crawler                     *bool          `json:"crawler"`
+	UserAgent                   *string        `json:"user_agent"`
+	Cookie                      *string        `json:"cookie"`
+	Username                    *string        `json:"username"`
+	Password                    *string        `json:"password"`
+	CategoryID                  *int64         `json:"category_id"`
+	Disabled                    *bool          `json:"disabled"`
+	NoMediaPlayer               *bool          `json:"no_media_player"`
+	IgnoreHTTPCache             *bool          `json:"ignore_http_cache"`
+	AllowSelfSignedCertificates *bool          `json:"allow_self_signed_certificates"`
+	ApplyFilterToContent        *bool          `json:"apply_filter_to_content"`
+	FetchViaProxy               *bool          `json:"fetch_via_proxy"`
+	HideGlobally                *bool          `json:"hide_globally"`
+	DisableHTTP2                *bool          `json:"disable_http2"`
+	PageSelectors               *PageSelectors `json:"page_selectors"`
 }
 
 // Patch updates a feed with modified values.
@@ -279,6 +319,10 @@ func (f *FeedModificationRequest) Patch(feed *Feed) {
 	if f.DisableHTTP2 != nil {
 		feed.DisableHTTP2 = *f.DisableHTTP2
 	}
+
+	if f.PageSelectors != nil {
+		feed.PageSelectors = *f.PageSelectors
+	}
 }
 
 // Feeds is a list of feed
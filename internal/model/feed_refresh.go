@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"net/http"
+	"time"
+)
+
+// FeedRefresh represents a refresh attempt of a feed.
+type FeedRefresh struct {
+	ID         int64     `json:"id"`
+	UserID     int64     `json:"user_id"`
+	FeedID     int64     `json:"feed_id"`
+	CheckedAt  time.Time `json:"checked_at"`
+	StatusCode int       `json:"status_code"`
+	Duration   int64     `json:"duration"`
+	Size       int64     `json:"size"`
+	NewEntries int       `json:"new_entries"`
+	Error      string    `json:"error"`
+	NewFeedURL string    `json:"new_feed_url"`
+}
+
+// NewFeedRefresh returns a refresh attempt of the given feed.
+func NewFeedRefresh(feed *Feed) *FeedRefresh {
+	return &FeedRefresh{
+		UserID:    feed.UserID,
+		FeedID:    feed.ID,
+		CheckedAt: time.Now(),
+	}
+}
+
+// WithDuration records the time spent to fetch the feed, in milliseconds.
+func (r *FeedRefresh) WithDuration(start time.Time) {
+	r.Duration = time.Since(start).Milliseconds()
+}
+
+// IsNotModified returns true if the server replied that the feed did not change.
+func (r *FeedRefresh) IsNotModified() bool {
+	return r.StatusCode == http.StatusNotModified
+}
+
+// FeedRefreshes represents a list of refresh attempts.
+type FeedRefreshes []*FeedRefresh
+
+// FeedHealth summarizes the recent refresh attempts of a feed.
+type FeedHealth struct {
+	FeedID          int64     `json:"feed_id"`
+	FeedTitle       string    `json:"feed_title"`
+	Refreshes       int       `json:"refreshes"`
+	Failures        int       `json:"failures"`
+	AverageDuration int64     `json:"average_duration"`
+	LastFailureAt   time.Time `json:"last_failure_at"`
+	LastError       string    `json:"last_error"`
+}
+
+// FailureRate returns the percentage of failed refresh attempts.
+func (h *FeedHealth) FailureRate() int {
+	if h.Refreshes == 0 {
+		return 0
+	}
+	return h.Failures * 100 / h.Refreshes
+}
+
+// FeedHealthList represents a list of feed health summaries.
+type FeedHealthList []*FeedHealth
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestFeedHealthFailureRate(t *testing.T) {
+	scenarios := []struct {
+		health   FeedHealth
+		expected int
+	}{
+		{FeedHealth{}, 0},
+		{FeedHealth{Refreshes: 4, Failures: 1}, 25},
+		{FeedHealth{Refreshes: 3, Failures: 3}, 100},
+	}
+
+	for _, tc := range scenarios {
+		if result := tc.health.FailureRate(); result != tc.expected {
+			t.Errorf(`Unexpected failure rate for %d/%d, got %d instead of %d`, tc.health.Failures, tc.health.Refreshes, result, tc.expected)
+		}
+	}
+}
+
+func TestFeedRefreshIsNotModified(t *testing.T) {
+	refresh := NewFeedRefresh(&Feed{ID: 1, UserID: 2})
+	if refresh.FeedID != 1 || refresh.UserID != 2 || refresh.CheckedAt.IsZero() {
+		t.Errorf(`Unexpected feed refresh: %+v`, refresh)
+	}
+
+	refresh.StatusCode = http.StatusNotModified
+	if !refresh.IsNotModified() {
+		t.Error(`A 304 response should be reported as not modified`)
+	}
+
+	refresh.StatusCode = http.StatusOK
+	if refresh.IsNotModified() {
+		t.Error(`A 200 response should not be reported as not modified`)
+	}
+}
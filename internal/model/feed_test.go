@@ -15,6 +15,7 @@ import (
 const (
 	largeWeeklyCount = 10080
 	noNewTTL         = 0
+	noCacheMaxAge    = 0
 )
 
 func TestFeedCategorySetter(t *testing.T) {
@@ -89,7 +90,7 @@ func TestFeedScheduleNextCheckDefault(t *testing.T) {
 	timeBefore := time.Now()
 	feed := &Feed{}
 	weeklyCount := 10
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -115,7 +116,7 @@ func TestFeedScheduleNextCheckRoundRobinMinInterval(t *testing.T) {
 	timeBefore := time.Now()
 	feed := &Feed{}
 	weeklyCount := 100
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -144,7 +145,7 @@ func TestFeedScheduleNextCheckEntryFrequencyMaxInterval(t *testing.T) {
 	feed := &Feed{}
 	// Use a very small weekly count to trigger the max interval
 	weeklyCount := 1
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -173,7 +174,7 @@ func TestFeedScheduleNextCheckEntryFrequencyMaxIntervalZeroWeeklyCount(t *testin
 	feed := &Feed{}
 	// Use a very small weekly count to trigger the max interval
 	weeklyCount := 0
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -202,7 +203,7 @@ func TestFeedScheduleNextCheckEntryFrequencyMinInterval(t *testing.T) {
 	feed := &Feed{}
 	// Use a very large weekly count to trigger the min interval
 	weeklyCount := largeWeeklyCount
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -228,7 +229,7 @@ func TestFeedScheduleNextCheckEntryFrequencyFactor(t *testing.T) {
 	timeBefore := time.Now()
 	feed := &Feed{}
 	weeklyCount := 7
-	feed.ScheduleNextCheck(weeklyCount, noNewTTL)
+	feed.ScheduleNextCheck(weeklyCount, noNewTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -260,7 +261,7 @@ func TestFeedScheduleNextCheckEntryFrequencySmallNewTTL(t *testing.T) {
 	weeklyCount := largeWeeklyCount
 	// TTL is smaller than minInterval.
 	newTTL := minInterval / 2
-	feed.ScheduleNextCheck(weeklyCount, newTTL)
+	feed.ScheduleNextCheck(weeklyCount, newTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -296,7 +297,7 @@ func TestFeedScheduleNextCheckEntryFrequencyLargeNewTTL(t *testing.T) {
 	weeklyCount := largeWeeklyCount
 	// TTL is larger than minInterval.
 	newTTL := minInterval * 2
-	feed.ScheduleNextCheck(weeklyCount, newTTL)
+	feed.ScheduleNextCheck(weeklyCount, newTTL, noCacheMaxAge)
 
 	if feed.NextCheckAt.IsZero() {
 		t.Error(`The next_check_at must be set`)
@@ -309,3 +310,110 @@ func TestFeedScheduleNextCheckEntryFrequencyLargeNewTTL(t *testing.T) {
 		t.Error(`The next_check_at should be after timeBefore + entry frequency min interval`)
 	}
 }
+
+func TestFeedScheduleNextCheckRoundRobinCacheMaxAge(t *testing.T) {
+	minInterval := 30
+	os.Clearenv()
+	os.Setenv("POLLING_SCHEDULER", "round_robin")
+	os.Setenv("SCHEDULER_ROUND_ROBIN_MIN_INTERVAL", fmt.Sprintf("%d", minInterval))
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	timeBefore := time.Now()
+	feed := &Feed{}
+	cacheMaxAge := minInterval * 4
+	feed.ScheduleNextCheck(0, noNewTTL, cacheMaxAge)
+
+	checkTargetInterval(t, feed, cacheMaxAge, timeBefore, "cache max age")
+
+	// A short cache max age must not shorten the interval.
+	timeBefore = time.Now()
+	feed.ScheduleNextCheck(0, noNewTTL, minInterval/2)
+
+	checkTargetInterval(t, feed, minInterval, timeBefore, "round robin min interval")
+}
+
+func TestFeedScheduleNextCheckEntryFrequencyLargeCacheMaxAge(t *testing.T) {
+	maxInterval := 500
+	minInterval := 100
+	os.Clearenv()
+	os.Setenv("POLLING_SCHEDULER", "entry_frequency")
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", fmt.Sprintf("%d", maxInterval))
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MIN_INTERVAL", fmt.Sprintf("%d", minInterval))
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	timeBefore := time.Now()
+	feed := &Feed{}
+	// The cache max age is bounded by the max interval.
+	feed.ScheduleNextCheck(largeWeeklyCount, noNewTTL, maxInterval*10)
+
+	checkTargetInterval(t, feed, maxInterval, timeBefore, "entry frequency max interval")
+}
+
+func TestFeedPostponeNextCheck(t *testing.T) {
+	maxInterval := 60
+	os.Clearenv()
+	os.Setenv("SCHEDULER_ENTRY_FREQUENCY_MAX_INTERVAL", fmt.Sprintf("%d", maxInterval))
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	timeBefore := time.Now()
+	feed := &Feed{NextCheckAt: timeBefore.Add(5 * time.Minute)}
+
+	feed.PostponeNextCheck(time.Minute)
+	if !feed.NextCheckAt.Equal(timeBefore.Add(5 * time.Minute)) {
+		t.Error(`The next_check_at should not be moved earlier`)
+	}
+
+	feed.PostponeNextCheck(30 * time.Minute)
+	if feed.NextCheckAt.Before(timeBefore.Add(30 * time.Minute)) {
+		t.Error(`The next_check_at should be after timeBefore + Retry-After delay`)
+	}
+
+	feed.PostponeNextCheck(24 * time.Hour)
+	if feed.NextCheckAt.After(time.Now().Add(time.Minute * time.Duration(maxInterval))) {
+		t.Error(`The next_check_at should not exceed the entry frequency max interval`)
+	}
+}
+
+func TestFeedTrackPermanentRedirect(t *testing.T) {
+	feed := &Feed{}
+
+	scenarios := []struct {
+		redirectURL string
+		expected    int
+	}{
+		{"https://example.org/a", 1},
+		{"https://example.org/a", 2},
+		{"https://example.org/b", 1},
+		{"https://example.org/b", 2},
+		{"", 0},
+		{"https://example.org/b", 1},
+	}
+
+	for i, tc := range scenarios {
+		if result := feed.TrackPermanentRedirect(tc.redirectURL); result != tc.expected {
+			t.Errorf(`Unexpected count for refresh #%d, got %d instead of %d`, i, result, tc.expected)
+		}
+
+		if feed.PermanentRedirectURL != tc.redirectURL {
+			t.Errorf(`Unexpected redirect URL for refresh #%d, got %q instead of %q`, i, feed.PermanentRedirectURL, tc.redirectURL)
+		}
+	}
+}
@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// List of actions applied to stored entries matching the filter rules.
+const (
+	FilterActionRead   = "read"
+	FilterActionRemove = "remove"
+)
+
+// FilterRulesRequest represents the request to apply the filter rules to stored entries.
+type FilterRulesRequest struct {
+	Action string `json:"action"`
+	DryRun bool   `json:"dry_run"`
+}
+
+// FilterRulesResult reports how many stored entries have been checked and matched by the filter rules.
+type FilterRulesResult struct {
+	Action         string `json:"action"`
+	DryRun         bool   `json:"dry_run"`
+	CheckedEntries int    `json:"checked_entries"`
+	MatchedEntries int    `json:"matched_entries"`
+}
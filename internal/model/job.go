@@ -3,10 +3,28 @@
 
 package model // import "miniflux.app/v2/internal/model"
 
+import "time"
+
+// Job statuses.
+const (
+	JobStatusQueued  = "queued"
+	JobStatusRunning = "running"
+)
+
 // Job represents a payload sent to the processing queue.
 type Job struct {
-	UserID int64
-	FeedID int64
+	ID        int64      `json:"id"`
+	UserID    int64      `json:"user_id"`
+	FeedID    int64      `json:"feed_id"`
+	Status    string     `json:"status"`
+	Attempts  int        `json:"attempts"`
+	LastError string     `json:"last_error"`
+	RunAt     time.Time  `json:"run_at"`
+	StartedAt *time.Time `json:"started_at"`
+	CreatedAt time.Time  `json:"created_at"`
+
+	// Payload is the feed content pushed by a WebSub hub, the feed is fetched when it is empty.
+	Payload []byte `json:"-"`
 }
 
 // JobList represents a list of jobs.
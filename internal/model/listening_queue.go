@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// ListeningItem represents an audio or video enclosure with the entry and the feed it belongs to.
+type ListeningItem struct {
+	Enclosure  *Enclosure `json:"enclosure"`
+	EntryTitle string     `json:"entry_title"`
+	EntryURL   string     `json:"entry_url"`
+	FeedID     int64      `json:"feed_id"`
+	FeedTitle  string     `json:"feed_title"`
+	IconID     int64      `json:"-"`
+	Queued     bool       `json:"queued"`
+	Position   int        `json:"position"`
+}
+
+// ListeningItems represents a list of listening items.
+type ListeningItems []*ListeningItem
+
+// ListeningQueueAddRequest represents a request to append an enclosure to the listening queue.
+type ListeningQueueAddRequest struct {
+	EnclosureID int64 `json:"enclosure_id"`
+}
+
+// ListeningQueueOrderRequest represents a request to reorder the listening queue.
+type ListeningQueueOrderRequest struct {
+	EnclosureIDs []int64 `json:"enclosure_ids"`
+}
@@ -0,0 +1,15 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// PageSelectors contains the CSS selectors used to generate a feed from a web page that doesn't publish any feed.
+//
+// The item selector matches the entries of the page, the other selectors are relative to each item.
+type PageSelectors struct {
+	Item    string `json:"item"`
+	Title   string `json:"title"`
+	Link    string `json:"link"`
+	Date    string `json:"date"`
+	Content string `json:"content"`
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// PodcastMetadata contains the Podcasting 2.0 information of an entry.
+type PodcastMetadata struct {
+	Season       string              `json:"season,omitempty"`
+	Episode      string              `json:"episode,omitempty"`
+	ChaptersURL  string              `json:"chapters_url,omitempty"`
+	ChaptersType string              `json:"chapters_type,omitempty"`
+	Transcripts  []PodcastTranscript `json:"transcripts,omitempty"`
+	Persons      []PodcastPerson     `json:"persons,omitempty"`
+	Funding      []PodcastFunding    `json:"funding,omitempty"`
+}
+
+// PodcastTranscript is a link to the transcript or the closed captions of an episode.
+type PodcastTranscript struct {
+	URL      string `json:"url"`
+	Type     string `json:"type"`
+	Language string `json:"language,omitempty"`
+	Rel      string `json:"rel,omitempty"`
+}
+
+// PodcastPerson is a person involved in an episode, like a host or a guest.
+type PodcastPerson struct {
+	Name  string `json:"name"`
+	Role  string `json:"role,omitempty"`
+	Group string `json:"group,omitempty"`
+	Image string `json:"image,omitempty"`
+	URL   string `json:"url,omitempty"`
+}
+
+// PodcastFunding is a link to donate or subscribe to the podcast.
+type PodcastFunding struct {
+	URL   string `json:"url"`
+	Title string `json:"title,omitempty"`
+}
+
+// PodcastChapter is a chapter of an episode, loaded from the chapters file.
+type PodcastChapter struct {
+	StartTime float64 `json:"start_time"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url,omitempty"`
+	Image     string  `json:"image,omitempty"`
+}
+
+// IsEmpty returns true if the entry doesn't have any podcast information.
+func (p PodcastMetadata) IsEmpty() bool {
+	return p.Season == "" && p.Episode == "" && p.ChaptersURL == "" && len(p.Transcripts) == 0 && len(p.Persons) == 0 && len(p.Funding) == 0
+}
+
+// Value converts the podcast metadata to JSON.
+func (p PodcastMetadata) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
+// Scan converts raw JSON data.
+func (p *PodcastMetadata) Scan(src interface{}) error {
+	source, ok := src.([]byte)
+	if !ok {
+		return errors.New("podcast: unable to assert type of src")
+	}
+
+	if err := json.Unmarshal(source, p); err != nil {
+		return fmt.Errorf("podcast: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+// ScraperPreviewRequest represents the request to test scraper and rewrite rules on an entry of a feed.
+// The rules of the feed are used when they are not specified, and the most recent entry when the entry ID is zero.
+type ScraperPreviewRequest struct {
+	EntryID      int64   `json:"entry_id"`
+	ScraperRules *string `json:"scraper_rules"`
+	RewriteRules *string `json:"rewrite_rules"`
+}
+
+// Patch overrides the rules of the feed with the rules of the request.
+func (s *ScraperPreviewRequest) Patch(feed *Feed) {
+	if s.ScraperRules != nil {
+		feed.ScraperRules = *s.ScraperRules
+	}
+
+	if s.RewriteRules != nil {
+		feed.RewriteRules = *s.RewriteRules
+	}
+}
+
+// ScraperPreview contains the stored content of an entry and the content produced by the previewed rules.
+type ScraperPreview struct {
+	EntryID         int64  `json:"entry_id"`
+	URL             string `json:"url"`
+	ScraperRules    string `json:"scraper_rules"`
+	RewriteRules    string `json:"rewrite_rules"`
+	OriginalContent string `json:"original_content"`
+	Content         string `json:"content"`
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import (
+	"strings"
+	"time"
+)
+
+// SiteRule represents the scraper and rewrite rules of a website.
+// The instance-wide rules are managed by administrators and apply to all users.
+type SiteRule struct {
+	ID           int64     `json:"id"`
+	UserID       int64     `json:"user_id"`
+	InstanceWide bool      `json:"instance_wide"`
+	Domain       string    `json:"domain"`
+	ScraperRules string    `json:"scraper_rules"`
+	RewriteRules string    `json:"rewrite_rules"`
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// SiteRules represents a list of site rules.
+type SiteRules []*SiteRule
+
+// ScraperRules returns the first scraper rules defined in the list.
+func (s SiteRules) ScraperRules() string {
+	for _, siteRule := range s {
+		if siteRule.ScraperRules != "" {
+			return siteRule.ScraperRules
+		}
+	}
+	return ""
+}
+
+// RewriteRules returns the first rewrite rules defined in the list.
+func (s SiteRules) RewriteRules() string {
+	for _, siteRule := range s {
+		if siteRule.RewriteRules != "" {
+			return siteRule.RewriteRules
+		}
+	}
+	return ""
+}
+
+// Export returns the site rules in the format used to share them.
+func (s SiteRules) Export() SiteRuleRequests {
+	requests := make(SiteRuleRequests, 0, len(s))
+	for _, siteRule := range s {
+		requests = append(requests, &SiteRuleRequest{
+			Domain:       siteRule.Domain,
+			ScraperRules: siteRule.ScraperRules,
+			RewriteRules: siteRule.RewriteRules,
+			InstanceWide: siteRule.InstanceWide,
+		})
+	}
+	return requests
+}
+
+// SiteRuleRequest represents the request to create a site rule.
+type SiteRuleRequest struct {
+	Domain       string `json:"domain"`
+	ScraperRules string `json:"scraper_rules"`
+	RewriteRules string `json:"rewrite_rules"`
+	InstanceWide bool   `json:"instance_wide"`
+}
+
+// SiteRuleRequests is a list of site rules, in the JSON format used to import and export them.
+type SiteRuleRequests []*SiteRuleRequest
+
+// AsUserRules turns the instance-wide rules into rules of the user,
+// users who are not administrators can import rule packs exported by an administrator.
+func (s SiteRuleRequests) AsUserRules() {
+	for _, request := range s {
+		request.InstanceWide = false
+	}
+}
+
+// SiteRuleModificationRequest represents the request to update a site rule.
+type SiteRuleModificationRequest struct {
+	Domain       *string `json:"domain"`
+	ScraperRules *string `json:"scraper_rules"`
+	RewriteRules *string `json:"rewrite_rules"`
+}
+
+// Patch updates site rule fields.
+func (s *SiteRuleModificationRequest) Patch(siteRule *SiteRule) {
+	if s.Domain != nil {
+		siteRule.Domain = NormalizeSiteRuleDomain(*s.Domain)
+	}
+
+	if s.ScraperRules != nil {
+		siteRule.ScraperRules = *s.ScraperRules
+	}
+
+	if s.RewriteRules != nil {
+		siteRule.RewriteRules = *s.RewriteRules
+	}
+}
+
+// NormalizeSiteRuleDomain returns the domain in lower case without the "www." prefix, like the predefined rules.
+func NormalizeSiteRuleDomain(domain string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "testing"
+
+func TestSiteRulesPriority(t *testing.T) {
+	siteRules := SiteRules{
+		{UserID: 1, Domain: "blog.example.org", RewriteRules: "add_image_title"},
+		{UserID: 1, Domain: "example.org", ScraperRules: "article"},
+		{InstanceWide: true, Domain: "example.org", ScraperRules: "main", RewriteRules: "remove_tables"},
+	}
+
+	if rules := siteRules.ScraperRules(); rules != "article" {
+		t.Errorf(`Unexpected scraper rules, got %q`, rules)
+	}
+
+	if rules := siteRules.RewriteRules(); rules != "add_image_title" {
+		t.Errorf(`Unexpected rewrite rules, got %q`, rules)
+	}
+
+	if rules := SiteRules(nil).ScraperRules(); rules != "" {
+		t.Errorf(`An empty list should not return any rules, got %q`, rules)
+	}
+}
+
+func TestSiteRulesExport(t *testing.T) {
+	siteRules := SiteRules{
+		{ID: 1, UserID: 1, Domain: "example.org", ScraperRules: "article"},
+		{ID: 2, InstanceWide: true, Domain: "example.com", RewriteRules: "remove_tables"},
+	}
+
+	requests := siteRules.Export()
+	if len(requests) != 2 || requests[0].Domain != "example.org" || requests[0].InstanceWide || !requests[1].InstanceWide {
+		t.Errorf(`Unexpected exported rules: %+v, %+v`, requests[0], requests[1])
+	}
+
+	requests.AsUserRules()
+	if requests[1].InstanceWide {
+		t.Error(`The imported rules should belong to the user`)
+	}
+}
+
+func TestNormalizeSiteRuleDomain(t *testing.T) {
+	scenarios := map[string]string{
+		"example.org":           "example.org",
+		" WWW.Example.ORG ":     "example.org",
+		"blog.example.org":      "blog.example.org",
+		"www2.example.org":      "www2.example.org",
+		"https://example.org/a": "https://example.org/a",
+	}
+
+	for input, expected := range scenarios {
+		if result := NormalizeSiteRuleDomain(input); result != expected {
+			t.Errorf(`Unexpected domain for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
@@ -36,6 +36,10 @@ type User struct {
 	CategoriesSortingOrder string     `json:"categories_sorting_order"`
 	MarkReadOnView         bool       `json:"mark_read_on_view"`
 	MediaPlaybackRate      float64    `json:"media_playback_rate"`
+	BlockFilterEntryRules  string     `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules   string     `json:"keep_filter_entry_rules"`
+	EntryActionRules       string     `json:"entry_action_rules"`
+	DeduplicateEntries     bool       `json:"deduplicate_entries"`
 }
 
 // UserCreationRequest represents the request to create a user.
@@ -72,6 +76,10 @@ type UserModificationRequest struct {
 	CategoriesSortingOrder *string  `json:"categories_sorting_order"`
 	MarkReadOnView         *bool    `json:"mark_read_on_view"`
 	MediaPlaybackRate      *float64 `json:"media_playback_rate"`
+	BlockFilterEntryRules  *string  `json:"block_filter_entry_rules"`
+	KeepFilterEntryRules   *string  `json:"keep_filter_entry_rules"`
+	EntryActionRules       *string  `json:"entry_action_rules"`
+	DeduplicateEntries     *bool    `json:"deduplicate_entries"`
 }
 
 // Patch updates the User object with the modification request.
@@ -167,6 +175,22 @@ func (u *UserModificationRequest) Patch(user *User) {
 	if u.MediaPlaybackRate != nil {
 		user.MediaPlaybackRate = *u.MediaPlaybackRate
 	}
+
+	if u.BlockFilterEntryRules != nil {
+		user.BlockFilterEntryRules = *u.BlockFilterEntryRules
+	}
+
+	if u.KeepFilterEntryRules != nil {
+		user.KeepFilterEntryRules = *u.KeepFilterEntryRules
+	}
+
+	if u.EntryActionRules != nil {
+		user.EntryActionRules = *u.EntryActionRules
+	}
+
+	if u.DeduplicateEntries != nil {
+		user.DeduplicateEntries = *u.DeduplicateEntries
+	}
 }
 
 // UseTimezone converts last login date to the given timezone.
@@ -0,0 +1,26 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package model // import "miniflux.app/v2/internal/model"
+
+import "time"
+
+// WebSub subscription states.
+const (
+	WebSubStatePending  = "pending"
+	WebSubStateVerified = "verified"
+	WebSubStateDenied   = "denied"
+)
+
+// WebSubSubscription represents the subscription of a feed to a WebSub hub.
+type WebSubSubscription struct {
+	FeedID         int64
+	UserID         int64
+	HubURL         string
+	TopicURL       string
+	CallbackToken  string
+	Secret         string
+	State          string
+	LeaseExpiresAt *time.Time
+	RequestedAt    *time.Time
+}
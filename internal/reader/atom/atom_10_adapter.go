@@ -49,6 +49,13 @@ func (a *Atom10Adapter) BuildFeed(baseURL string) *model.Feed {
 		feed.SiteURL = baseURL
 	}
 
+	// Populate the WebSub hub URL.
+	if hubURL := a.atomFeed.Links.firstLinkWithRelation("hub"); hubURL != "" {
+		if absoluteHubURL, err := urllib.AbsoluteURL(baseURL, hubURL); err == nil {
+			feed.HubURL = absoluteHubURL
+		}
+	}
+
 	// Populate the feed title.
 	feed.Title = a.atomFeed.Title.Body()
 	if feed.Title == "" {
@@ -215,11 +222,20 @@ func (a *Atom10Adapter) populateEntries(siteURL string) model.Entries {
 			} else {
 				if _, found := uniqueEnclosuresMap[mediaAbsoluteURL]; !found {
 					uniqueEnclosuresMap[mediaAbsoluteURL] = true
-					entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
+					width, height := mediaContent.Dimensions()
+					enclosure := &model.Enclosure{
 						URL:      mediaAbsoluteURL,
 						MimeType: mediaContent.MimeType(),
 						Size:     mediaContent.Size(),
-					})
+						Duration: mediaContent.DurationInSeconds(),
+						Width:    width,
+						Height:   height,
+						Title:    mediaContent.Title(),
+					}
+					if thumbnailURL := mediaContent.ThumbnailURL(); thumbnailURL != "" {
+						enclosure.ThumbnailURL, _ = urllib.AbsoluteURL(siteURL, thumbnailURL)
+					}
+					entry.Enclosures = append(entry.Enclosures, enclosure)
 				}
 			}
 		}
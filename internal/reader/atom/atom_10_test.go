@@ -217,6 +217,26 @@ func TestParseFeedURL(t *testing.T) {
 	}
 }
 
+func TestParseFeedHubURL(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+	<feed xmlns="http://www.w3.org/2005/Atom">
+	  <title>Example Feed</title>
+	  <link rel="alternate" type="text/html" href="https://example.org/"/>
+	  <link rel="self" type="application/atom+xml" href="https://example.org/feed"/>
+	  <link rel="hub" href="https://hub.example.org/"/>
+	  <updated>2003-12-13T18:30:02Z</updated>
+	</feed>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+}
+
 func TestParseFeedWithRelativeFeedURL(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 	<feed xmlns="http://www.w3.org/2005/Atom">
@@ -1547,6 +1567,10 @@ A website: http://example.org/</media:description>
 			t.Errorf(`Unexpected enclosure size, got %d instead of %d`, enclosure.Size, expectedResults[index].size)
 		}
 	}
+
+	if mediaContent := feed.Entries[0].Enclosures[1]; mediaContent.Width != 640 || mediaContent.Height != 390 {
+		t.Errorf(`Unexpected media content dimensions, got %dx%d`, mediaContent.Width, mediaContent.Height)
+	}
 }
 
 func TestParseRepliesLinkRelationWithHTMLType(t *testing.T) {
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package atom // import "miniflux.app/v2/internal/reader/atom"
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// FeedProperties describes the feed generated by Serialize.
+type FeedProperties struct {
+	ID           string
+	Title        string
+	SelfURL      string
+	AlternateURL string
+
+	// EntryURL returns the link of an entry, the entry URL is used when nil.
+	EntryURL func(entry *model.Entry) string
+}
+
+type atomFeedDocument struct {
+	XMLName   xml.Name           `xml:"feed"`
+	Namespace string             `xml:"xmlns,attr"`
+	ID        string             `xml:"id"`
+	Title     string             `xml:"title"`
+	Updated   string             `xml:"updated"`
+	Generator string             `xml:"generator"`
+	Links     []atomLinkElement  `xml:"link"`
+	Entries   []atomEntryElement `xml:"entry"`
+}
+
+type atomEntryElement struct {
+	ID         string                `xml:"id"`
+	Title      string                `xml:"title"`
+	Published  string                `xml:"published"`
+	Updated    string                `xml:"updated"`
+	Links      []atomLinkElement     `xml:"link"`
+	Authors    []atomAuthorElement   `xml:"author"`
+	Categories []atomCategoryElement `xml:"category"`
+	Content    atomContentElement    `xml:"content"`
+}
+
+type atomLinkElement struct {
+	Href   string `xml:"href,attr"`
+	Rel    string `xml:"rel,attr,omitempty"`
+	Type   string `xml:"type,attr,omitempty"`
+	Length string `xml:"length,attr,omitempty"`
+}
+
+type atomAuthorElement struct {
+	Name string `xml:"name"`
+}
+
+type atomCategoryElement struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomContentElement struct {
+	Type string `xml:"type,attr"`
+	Data string `xml:",chardata"`
+}
+
+// Serialize returns an Atom 1.0 document that contains the given entries.
+func Serialize(properties FeedProperties, entries model.Entries) ([]byte, error) {
+	document := atomFeedDocument{
+		Namespace: "http://www.w3.org/2005/Atom",
+		ID:        properties.ID,
+		Title:     properties.Title,
+		Generator: "Miniflux",
+		Links: []atomLinkElement{
+			{Href: properties.SelfURL, Rel: "self", Type: "application/atom+xml"},
+			{Href: properties.AlternateURL, Rel: "alternate", Type: "text/html"},
+		},
+		Entries: make([]atomEntryElement, 0, len(entries)),
+	}
+
+	var lastUpdated time.Time
+	for _, entry := range entries {
+		updated := entryUpdatedDate(entry)
+		if updated.After(lastUpdated) {
+			lastUpdated = updated
+		}
+
+		entryURL := entry.URL
+		if properties.EntryURL != nil {
+			entryURL = properties.EntryURL(entry)
+		}
+
+		element := atomEntryElement{
+			ID:        entry.URL,
+			Title:     entry.Title,
+			Published: entry.Date.UTC().Format(time.RFC3339),
+			Updated:   updated.UTC().Format(time.RFC3339),
+			Links:     []atomLinkElement{{Href: entryURL, Rel: "alternate", Type: "text/html"}},
+			Content:   atomContentElement{Type: "html", Data: entry.Content},
+		}
+
+		if entryURL != entry.URL {
+			element.Links = append(element.Links, atomLinkElement{Href: entry.URL, Rel: "related", Type: "text/html"})
+		}
+
+		for _, authorName := range strings.Split(entry.Author, ",") {
+			authorName = strings.TrimSpace(authorName)
+			if authorName != "" {
+				element.Authors = append(element.Authors, atomAuthorElement{Name: authorName})
+			}
+		}
+
+		for _, tag := range entry.Tags {
+			element.Categories = append(element.Categories, atomCategoryElement{Term: tag})
+		}
+
+		for _, enclosure := range entry.Enclosures {
+			link := atomLinkElement{Href: enclosure.URL, Rel: "enclosure", Type: enclosure.MimeType}
+			if enclosure.Size > 0 {
+				link.Length = strconv.FormatInt(enclosure.Size, 10)
+			}
+			element.Links = append(element.Links, link)
+		}
+
+		document.Entries = append(document.Entries, element)
+	}
+
+	if lastUpdated.IsZero() {
+		lastUpdated = time.Now()
+	}
+	document.Updated = lastUpdated.UTC().Format(time.RFC3339)
+
+	var b bytes.Buffer
+	b.WriteString(xml.Header)
+
+	encoder := xml.NewEncoder(&b)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+func entryUpdatedDate(entry *model.Entry) time.Time {
+	if entry.ChangedAt.After(entry.Date) {
+		return entry.ChangedAt
+	}
+	return entry.Date
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package atom // import "miniflux.app/v2/internal/reader/atom"
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestSerializedFeedCanBeParsed(t *testing.T) {
+	entries := model.Entries{
+		{
+			ID:      1,
+			URL:     "https://example.org/article",
+			Title:   "Article & Co",
+			Content: "<p>Content</p>",
+			Author:  "Author A, Author B",
+			Date:    time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			Tags:    []string{"tag"},
+			Enclosures: model.EnclosureList{
+				{URL: "https://example.org/podcast.mp3", MimeType: "audio/mpeg", Size: 1024},
+			},
+		},
+	}
+
+	data, err := Serialize(FeedProperties{
+		ID:           "https://miniflux.example.org/atom/token/starred",
+		Title:        "Starred",
+		SelfURL:      "https://miniflux.example.org/atom/token/starred",
+		AlternateURL: "https://miniflux.example.org/starred",
+	}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := Parse("https://miniflux.example.org/atom/token/starred", bytes.NewReader(data), "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Starred" || feed.SiteURL != "https://miniflux.example.org/starred" {
+		t.Errorf("Incorrect feed, got title %q and site URL %q", feed.Title, feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 1 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "Article & Co" || entry.URL != "https://example.org/article" || entry.Content != "<p>Content</p>" {
+		t.Errorf("Incorrect entry, got: %+v", entry)
+	}
+
+	if entry.Author != "Author A, Author B" || !entry.Date.Equal(entries[0].Date) {
+		t.Errorf("Incorrect entry author or date, got %q and %v", entry.Author, entry.Date)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].Size != 1024 {
+		t.Errorf("Incorrect entry enclosures, got: %+v", entry.Enclosures)
+	}
+
+	if len(entry.Tags) != 1 || entry.Tags[0] != "tag" {
+		t.Errorf("Incorrect entry tags, got: %v", entry.Tags)
+	}
+}
+
+func TestSerializeWithCustomEntryURL(t *testing.T) {
+	entries := model.Entries{
+		{URL: "https://example.org/article", ShareCode: "abc", Date: time.Now()},
+	}
+
+	data, err := Serialize(FeedProperties{
+		Title: "Shared",
+		EntryURL: func(entry *model.Entry) string {
+			return "https://miniflux.example.org/share/" + entry.ShareCode
+		},
+	}, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, expected := range []string{
+		`<link href="https://miniflux.example.org/share/abc" rel="alternate" type="text/html"></link>`,
+		`<link href="https://example.org/article" rel="related" type="text/html"></link>`,
+	} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("The document should contain %q, got: %s", expected, data)
+		}
+	}
+}
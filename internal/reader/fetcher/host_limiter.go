@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package fetcher // import "miniflux.app/v2/internal/reader/fetcher"
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// hostIdleTimeout is the minimum time before forgetting the state of a host that didn't receive any request.
+const hostIdleTimeout = 10 * time.Minute
+
+var (
+	hostLimits      = newHostLimiter(0, 0, 0)
+	hostLimitsMutex sync.RWMutex
+)
+
+// SetHostLimits configures the maximum number of requests per minute, the number of requests that can be sent at once
+// after an idle period, and the maximum number of concurrent requests sent to the same host. A zero value disables the limit.
+func SetHostLimits(requestsPerMinute, burst, maxConcurrency int) {
+	hostLimitsMutex.Lock()
+	defer hostLimitsMutex.Unlock()
+	hostLimits = newHostLimiter(requestsPerMinute, burst, maxConcurrency)
+}
+
+func currentHostLimiter() *hostLimiter {
+	hostLimitsMutex.RLock()
+	defer hostLimitsMutex.RUnlock()
+	return hostLimits
+}
+
+// hostLimiter throttles outgoing requests with a token bucket per host and caps the number of concurrent requests.
+type hostLimiter struct {
+	mu             sync.Mutex
+	hosts          map[string]*hostState
+	lastCleanup    time.Time
+	interval       time.Duration
+	burst          int
+	maxConcurrency int
+}
+
+type hostState struct {
+	slots chan struct{}
+
+	// users is the number of requests waiting for or holding a slot, the state is not evicted while in use.
+	users int
+
+	// tokens is the number of requests that can be sent right away,
+	// it becomes negative when requests are waiting for the bucket to refill.
+	tokens float64
+
+	// updatedAt is the last time the tokens were counted.
+	updatedAt time.Time
+
+	// lastUsed is the last time a request to the host was done.
+	lastUsed time.Time
+}
+
+func newHostLimiter(requestsPerMinute, burst, maxConcurrency int) *hostLimiter {
+	limiter := &hostLimiter{
+		hosts:          make(map[string]*hostState),
+		lastCleanup:    time.Now(),
+		burst:          max(burst, 1),
+		maxConcurrency: maxConcurrency,
+	}
+
+	if requestsPerMinute > 0 {
+		limiter.interval = time.Minute / time.Duration(requestsPerMinute)
+	}
+
+	return limiter
+}
+
+// acquire blocks until a request can be sent to the host, the returned function must be called once the request is done.
+func (l *hostLimiter) acquire(host string) (release func()) {
+	if l.interval == 0 && l.maxConcurrency <= 0 {
+		return func() {}
+	}
+
+	state := l.state(host)
+
+	if state.slots != nil {
+		state.slots <- struct{}{}
+	}
+
+	if delay := l.reserve(state, time.Now()); delay > 0 {
+		time.Sleep(delay)
+	}
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			if state.slots != nil {
+				<-state.slots
+			}
+			l.done(state, time.Now())
+		})
+	}
+}
+
+// state returns the state of the host and marks it as used until done is called.
+func (l *hostLimiter) state(host string) *hostState {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastCleanup) >= hostIdleTimeout {
+		l.evictIdleHosts(now)
+	}
+
+	state, found := l.hosts[host]
+	if !found {
+		state = &hostState{tokens: float64(l.burst), updatedAt: now}
+		if l.maxConcurrency > 0 {
+			state.slots = make(chan struct{}, l.maxConcurrency)
+		}
+		l.hosts[host] = state
+	}
+
+	state.users++
+	return state
+}
+
+func (l *hostLimiter) done(state *hostState, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	state.users--
+	state.lastUsed = now
+}
+
+// evictIdleHosts forgets the hosts without pending requests once their bucket is full again.
+func (l *hostLimiter) evictIdleHosts(now time.Time) {
+	idleTimeout := max(hostIdleTimeout, time.Duration(l.burst)*l.interval)
+	for host, state := range l.hosts {
+		if state.users == 0 && now.Sub(state.lastUsed) >= idleTimeout {
+			delete(l.hosts, host)
+		}
+	}
+	l.lastCleanup = now
+}
+
+// reserve takes a token from the bucket of the host and returns how long the caller has to wait for it.
+func (l *hostLimiter) reserve(state *hostState, now time.Time) time.Duration {
+	if l.interval == 0 {
+		return 0
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if elapsed := now.Sub(state.updatedAt); elapsed > 0 {
+		state.tokens = min(float64(l.burst), state.tokens+float64(elapsed)/float64(l.interval))
+		state.updatedAt = now
+	}
+
+	state.tokens--
+	if state.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-state.tokens * float64(l.interval))
+}
+
+// releaseOnCloseBody releases the host slot once the response body is entirely read or closed.
+type releaseOnCloseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseOnCloseBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.release()
+	}
+	return n, err
+}
+
+func (b *releaseOnCloseBody) Close() error {
+	defer b.release()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package fetcher // import "miniflux.app/v2/internal/reader/fetcher"
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHostLimiterReserve(t *testing.T) {
+	limiter := newHostLimiter(60, 1, 0)
+	state := limiter.state("example.org")
+	now := time.Now()
+
+	for i, expected := range []time.Duration{0, time.Second, 2 * time.Second} {
+		if delay := limiter.reserve(state, now); delay != expected {
+			t.Errorf(`Unexpected delay for request #%d, got %v instead of %v`, i, delay, expected)
+		}
+	}
+
+	if delay := limiter.reserve(state, now.Add(10*time.Second)); delay != 0 {
+		t.Errorf(`The bucket should be refilled after a pause, got a delay of %v`, delay)
+	}
+
+	if delay := limiter.reserve(limiter.state("example.com"), now); delay != 0 {
+		t.Errorf(`Hosts should be limited independently, got a delay of %v`, delay)
+	}
+}
+
+func TestHostLimiterBurst(t *testing.T) {
+	limiter := newHostLimiter(60, 3, 0)
+	state := limiter.state("example.org")
+	now := time.Now()
+
+	for i, expected := range []time.Duration{0, 0, 0, time.Second, 2 * time.Second} {
+		if delay := limiter.reserve(state, now); delay != expected {
+			t.Errorf(`Unexpected delay for request #%d, got %v instead of %v`, i, delay, expected)
+		}
+	}
+
+	// After 4 seconds, the 2 pending requests are done and 2 tokens are available.
+	later := now.Add(4 * time.Second)
+	for i, expected := range []time.Duration{0, 0, time.Second} {
+		if delay := limiter.reserve(state, later); delay != expected {
+			t.Errorf(`Unexpected delay for request #%d after a pause, got %v instead of %v`, i, delay, expected)
+		}
+	}
+}
+
+func TestHostLimiterEvictIdleHosts(t *testing.T) {
+	limiter := newHostLimiter(60, 1, 1)
+	limiter.acquire("example.org")()
+	release := limiter.acquire("example.com")
+
+	limiter.evictIdleHosts(time.Now().Add(time.Hour))
+
+	if _, found := limiter.hosts["example.org"]; found {
+		t.Error(`Idle hosts should be evicted`)
+	}
+
+	if _, found := limiter.hosts["example.com"]; !found {
+		t.Error(`Hosts with a pending request should not be evicted`)
+	}
+
+	release()
+}
+
+func TestReleaseOnCloseBody(t *testing.T) {
+	released := 0
+	body := &releaseOnCloseBody{ReadCloser: io.NopCloser(strings.NewReader("content")), release: func() { released++ }}
+
+	if _, err := io.ReadAll(body); err != nil {
+		t.Fatal(err)
+	}
+
+	if released != 1 {
+		t.Errorf(`The slot should be released once the body is read`)
+	}
+}
+
+func TestHostLimiterMaxConcurrency(t *testing.T) {
+	limiter := newHostLimiter(0, 0, 1)
+	release := limiter.acquire("example.org")
+
+	acquired := make(chan struct{})
+	go func() {
+		limiter.acquire("example.org")()
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal(`The second request should wait for the first one`)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	release()
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal(`The second request should be sent once the first one is done`)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	scenarios := map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"-1":                            0,
+		"invalid":                       0,
+		"Mon, 01 Jan 2024 13:00:00 GMT": time.Hour,
+		"Mon, 01 Jan 2024 11:00:00 GMT": 0,
+	}
+
+	for input, expected := range scenarios {
+		if result := parseRetryAfter(input, now); result != expected {
+			t.Errorf(`Unexpected delay for %q, got %v instead of %v`, input, result, expected)
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"sync"
 	"time"
 )
@@ -114,8 +115,6 @@ func (r *RequestBuilder) IgnoreTLSErrors(value bool) *RequestBuilder {
 }
 
 func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, error) {
-	client := makeClient(r.clientConfig)
-
 	req, err := http.NewRequest("GET", requestURL, nil)
 	if err != nil {
 		return nil, err
@@ -124,6 +123,25 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 	req.Header = r.headers
 	req.Header.Set("Accept", defaultAcceptHeader)
 
+	return r.execute(req)
+}
+
+// PostForm sends the URL-encoded form values with a POST request.
+func (r *RequestBuilder) PostForm(requestURL string, values url.Values) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, requestURL, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header = r.headers.Clone()
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return r.execute(req)
+}
+
+func (r *RequestBuilder) execute(req *http.Request) (*http.Response, error) {
+	client := makeClient(r.clientConfig)
+
 	slog.Debug("Making outgoing request", slog.Group("request",
 		slog.String("method", req.Method),
 		slog.String("url", req.URL.String()),
@@ -135,7 +153,17 @@ func (r *RequestBuilder) ExecuteRequest(requestURL string) (*http.Response, erro
 		slog.Bool("disable_http2", r.clientConfig.disableHTTP2),
 	))
 
-	return client.Do(req)
+	release := currentHostLimiter().acquire(req.URL.Host)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+
+	// The host slot is held until the body is read, the response is not complete before.
+	resp.Body = &releaseOnCloseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
 }
 
 type clientConfig struct {
@@ -12,10 +12,16 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
+	"strings"
+	"time"
 
 	"miniflux.app/v2/internal/locale"
 )
 
+// ErrResourceGone is returned when the remote server indicates that the resource has been removed permanently.
+var ErrResourceGone = errors.New("fetcher: resource gone (410 status code)")
+
 type ResponseHandler struct {
 	httpResponse *http.Response
 	clientErr    error
@@ -25,10 +31,48 @@ func NewResponseHandler(httpResponse *http.Response, clientErr error) *ResponseH
 	return &ResponseHandler{httpResponse: httpResponse, clientErr: clientErr}
 }
 
+// StatusCode returns the HTTP status code of the response, or 0 if the request failed.
+func (r *ResponseHandler) StatusCode() int {
+	if r.httpResponse == nil {
+		return 0
+	}
+	return r.httpResponse.StatusCode
+}
+
 func (r *ResponseHandler) EffectiveURL() string {
 	return r.httpResponse.Request.URL.String()
 }
 
+// PermanentRedirectURL returns the URL reached by following the permanent redirects (301 and 308) of the original URL,
+// or an empty string if the original URL is not permanently redirected.
+func (r *ResponseHandler) PermanentRedirectURL() string {
+	if r.httpResponse == nil || r.httpResponse.Request == nil {
+		return ""
+	}
+
+	// Each redirected request references the response that caused the redirect, from the last request to the first one.
+	var requests []*http.Request
+	for request := r.httpResponse.Request; request != nil; {
+		requests = append([]*http.Request{request}, requests...)
+		if request.Response == nil {
+			break
+		}
+		request = request.Response.Request
+	}
+
+	permanentRedirectURL := ""
+	for _, request := range requests[1:] {
+		switch request.Response.StatusCode {
+		case http.StatusMovedPermanently, http.StatusPermanentRedirect:
+			permanentRedirectURL = request.URL.String()
+		default:
+			return permanentRedirectURL
+		}
+	}
+
+	return permanentRedirectURL
+}
+
 func (r *ResponseHandler) ContentType() string {
 	return r.httpResponse.Header.Get("Content-Type")
 }
@@ -65,6 +109,29 @@ func (r *ResponseHandler) IsModified(lastEtagValue, lastModifiedValue string) bo
 	return true
 }
 
+// RetryAfter returns the delay requested by the server with the Retry-After header of 429 and 503 responses.
+func (r *ResponseHandler) RetryAfter() time.Duration {
+	if r.httpResponse == nil {
+		return 0
+	}
+
+	switch r.httpResponse.StatusCode {
+	case http.StatusTooManyRequests, http.StatusServiceUnavailable:
+		return parseRetryAfter(r.httpResponse.Header.Get("Retry-After"), time.Now())
+	}
+
+	return 0
+}
+
+// CacheMaxAge returns how long the response stays fresh according to the Cache-Control and Expires headers.
+func (r *ResponseHandler) CacheMaxAge() time.Duration {
+	if r.httpResponse == nil {
+		return 0
+	}
+
+	return parseCacheMaxAge(r.httpResponse.Header, time.Now())
+}
+
 func (r *ResponseHandler) Close() {
 	if r.httpResponse != nil && r.httpResponse.Body != nil && r.clientErr == nil {
 		r.httpResponse.Body.Close()
@@ -100,11 +167,11 @@ func (r *ResponseHandler) LocalizedError() *locale.LocalizedErrorWrapper {
 		case isSSLError(r.clientErr):
 			return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: %w", r.clientErr), "error.tls_error", r.clientErr)
 		case isNetworkError(r.clientErr):
-			return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: %w", r.clientErr), "error.network_operation", r.clientErr)
+			return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: %w", r.clientErr)), "error.network_operation", r.clientErr)
 		case os.IsTimeout(r.clientErr):
-			return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: %w", r.clientErr), "error.network_timeout", r.clientErr)
+			return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: %w", r.clientErr)), "error.network_timeout", r.clientErr)
 		case errors.Is(r.clientErr, io.EOF):
-			return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: %w", r.clientErr), "error.http_empty_response")
+			return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: %w", r.clientErr)), "error.http_empty_response")
 		default:
 			return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: %w", r.clientErr), "error.http_client_error", r.clientErr)
 		}
@@ -116,17 +183,19 @@ func (r *ResponseHandler) LocalizedError() *locale.LocalizedErrorWrapper {
 	case http.StatusForbidden:
 		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: access forbidden (403 status code)"), "error.http_forbidden")
 	case http.StatusTooManyRequests:
-		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: too many requests (429 status code)"), "error.http_too_many_requests")
-	case http.StatusNotFound, http.StatusGone:
+		return locale.NewLocalizedErrorWrapper(&transientError{err: fmt.Errorf("fetcher: too many requests (429 status code)"), retryAfter: r.RetryAfter()}, "error.http_too_many_requests")
+	case http.StatusNotFound:
 		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: resource not found (%d status code)", r.httpResponse.StatusCode), "error.http_resource_not_found")
+	case http.StatusGone:
+		return locale.NewLocalizedErrorWrapper(ErrResourceGone, "error.http_resource_gone")
 	case http.StatusInternalServerError:
-		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: remote server error (%d status code)", r.httpResponse.StatusCode), "error.http_internal_server_error")
+		return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: remote server error (%d status code)", r.httpResponse.StatusCode)), "error.http_internal_server_error")
 	case http.StatusBadGateway:
-		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: bad gateway (%d status code)", r.httpResponse.StatusCode), "error.http_bad_gateway")
+		return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: bad gateway (%d status code)", r.httpResponse.StatusCode)), "error.http_bad_gateway")
 	case http.StatusServiceUnavailable:
-		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: service unavailable (%d status code)", r.httpResponse.StatusCode), "error.http_service_unavailable")
+		return locale.NewLocalizedErrorWrapper(&transientError{err: fmt.Errorf("fetcher: service unavailable (%d status code)", r.httpResponse.StatusCode), retryAfter: r.RetryAfter()}, "error.http_service_unavailable")
 	case http.StatusGatewayTimeout:
-		return locale.NewLocalizedErrorWrapper(fmt.Errorf("fetcher: gateway timeout (%d status code)", r.httpResponse.StatusCode), "error.http_gateway_timeout")
+		return locale.NewLocalizedErrorWrapper(transient(fmt.Errorf("fetcher: gateway timeout (%d status code)", r.httpResponse.StatusCode)), "error.http_gateway_timeout")
 	}
 
 	if r.httpResponse.StatusCode >= 400 {
@@ -143,6 +212,88 @@ func (r *ResponseHandler) LocalizedError() *locale.LocalizedErrorWrapper {
 	return nil
 }
 
+// transientError marks the errors that may go away when the request is retried later.
+type transientError struct {
+	err        error
+	retryAfter time.Duration
+}
+
+func transient(err error) error {
+	return &transientError{err: err}
+}
+
+func (e *transientError) Error() string {
+	return e.err.Error()
+}
+
+func (e *transientError) Unwrap() error {
+	return e.err
+}
+
+// IsTransientError returns true if the error is temporary: network errors, rate limiting or server errors.
+func IsTransientError(err error) bool {
+	var transientErr *transientError
+	return errors.As(err, &transientErr)
+}
+
+// RetryAfterDelay returns the minimum delay before retrying a transient error, as requested by the server.
+func RetryAfterDelay(err error) time.Duration {
+	var transientErr *transientError
+	if errors.As(err, &transientErr) {
+		return transientErr.retryAfter
+	}
+	return 0
+}
+
+// parseCacheMaxAge returns the remaining freshness lifetime of a response, Cache-Control max-age takes precedence over Expires.
+func parseCacheMaxAge(header http.Header, now time.Time) time.Duration {
+	if cacheControl := header.Get("Cache-Control"); cacheControl != "" {
+		for _, directive := range strings.Split(cacheControl, ",") {
+			name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+			switch strings.ToLower(name) {
+			case "no-cache", "no-store":
+				return 0
+			case "max-age":
+				seconds, err := strconv.Atoi(strings.Trim(value, `"`))
+				if err != nil {
+					return 0
+				}
+
+				age, _ := strconv.Atoi(header.Get("Age"))
+				return max(time.Duration(seconds-age)*time.Second, 0)
+			}
+		}
+	}
+
+	expires, err := http.ParseTime(header.Get("Expires"))
+	if err != nil {
+		return 0
+	}
+
+	if date, err := http.ParseTime(header.Get("Date")); err == nil {
+		now = date
+	}
+
+	return max(expires.Sub(now), 0)
+}
+
+// parseRetryAfter parses the Retry-After header, expressed in seconds or as an HTTP date.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+
+	return 0
+}
+
 func isNetworkError(err error) bool {
 	if _, ok := err.(*url.Error); ok {
 		return true
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package fetcher // import "miniflux.app/v2/internal/reader/fetcher"
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestIsTransientError(t *testing.T) {
+	scenarios := []struct {
+		statusCode int
+		expected   bool
+	}{
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+		{http.StatusNotFound, false},
+		{http.StatusGone, false},
+		{http.StatusForbidden, false},
+		{http.StatusTeapot, false},
+	}
+
+	for _, tc := range scenarios {
+		responseHandler := NewResponseHandler(&http.Response{StatusCode: tc.statusCode}, nil)
+		localizedError := responseHandler.LocalizedError()
+		if localizedError == nil {
+			t.Fatalf(`Status code %d should return an error`, tc.statusCode)
+		}
+
+		if result := IsTransientError(localizedError.Error()); result != tc.expected {
+			t.Errorf(`Unexpected result for status code %d, got %v instead of %v`, tc.statusCode, result, tc.expected)
+		}
+	}
+
+	if IsTransientError(errors.New("some error")) {
+		t.Error(`A generic error should not be transient`)
+	}
+}
+
+func TestResourceGoneError(t *testing.T) {
+	localizedError := NewResponseHandler(&http.Response{StatusCode: http.StatusGone}, nil).LocalizedError()
+	if localizedError == nil || !errors.Is(localizedError.Error(), ErrResourceGone) {
+		t.Errorf(`Status code 410 should return ErrResourceGone, got %v`, localizedError)
+	}
+
+	localizedError = NewResponseHandler(&http.Response{StatusCode: http.StatusNotFound}, nil).LocalizedError()
+	if localizedError == nil || errors.Is(localizedError.Error(), ErrResourceGone) {
+		t.Errorf(`Status code 404 should not return ErrResourceGone, got %v`, localizedError)
+	}
+}
+
+func TestParseCacheMaxAge(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	scenarios := []struct {
+		header   http.Header
+		expected time.Duration
+	}{
+		{http.Header{}, 0},
+		{http.Header{"Cache-Control": {"max-age=3600"}}, time.Hour},
+		{http.Header{"Cache-Control": {"public, max-age=600, must-revalidate"}}, 10 * time.Minute},
+		{http.Header{"Cache-Control": {"max-age=600"}, "Age": {"300"}}, 5 * time.Minute},
+		{http.Header{"Cache-Control": {"max-age=600"}, "Age": {"900"}}, 0},
+		{http.Header{"Cache-Control": {"max-age=invalid"}}, 0},
+		{http.Header{"Cache-Control": {"no-cache, max-age=600"}}, 0},
+		{http.Header{"Cache-Control": {"no-store"}, "Expires": {"Mon, 01 Jan 2024 13:00:00 GMT"}}, 0},
+		{http.Header{"Cache-Control": {"max-age=60"}, "Expires": {"Mon, 01 Jan 2024 13:00:00 GMT"}}, time.Minute},
+		{http.Header{"Expires": {"Mon, 01 Jan 2024 13:00:00 GMT"}}, time.Hour},
+		{http.Header{"Expires": {"Mon, 01 Jan 2024 13:00:00 GMT"}, "Date": {"Mon, 01 Jan 2024 12:30:00 GMT"}}, 30 * time.Minute},
+		{http.Header{"Expires": {"Mon, 01 Jan 2024 11:00:00 GMT"}}, 0},
+		{http.Header{"Expires": {"0"}}, 0},
+	}
+
+	for _, tc := range scenarios {
+		if result := parseCacheMaxAge(tc.header, now); result != tc.expected {
+			t.Errorf(`Unexpected max age for %v, got %v instead of %v`, tc.header, result, tc.expected)
+		}
+	}
+}
+
+func TestPermanentRedirectURL(t *testing.T) {
+	newRedirectChain := func(statusCodes ...int) *http.Response {
+		request, _ := http.NewRequest(http.MethodGet, "https://example.org/0", nil)
+		for i, statusCode := range statusCodes {
+			redirectedRequest, _ := http.NewRequest(http.MethodGet, fmt.Sprintf("https://example.org/%d", i+1), nil)
+			redirectedRequest.Response = &http.Response{StatusCode: statusCode, Request: request}
+			request = redirectedRequest
+		}
+		return &http.Response{StatusCode: http.StatusOK, Request: request}
+	}
+
+	scenarios := []struct {
+		statusCodes []int
+		expected    string
+	}{
+		{nil, ""},
+		{[]int{http.StatusMovedPermanently}, "https://example.org/1"},
+		{[]int{http.StatusPermanentRedirect}, "https://example.org/1"},
+		{[]int{http.StatusFound}, ""},
+		{[]int{http.StatusTemporaryRedirect}, ""},
+		{[]int{http.StatusMovedPermanently, http.StatusPermanentRedirect}, "https://example.org/2"},
+		{[]int{http.StatusMovedPermanently, http.StatusFound}, "https://example.org/1"},
+		{[]int{http.StatusFound, http.StatusMovedPermanently}, ""},
+	}
+
+	for _, tc := range scenarios {
+		responseHandler := NewResponseHandler(newRedirectChain(tc.statusCodes...), nil)
+		if result := responseHandler.PermanentRedirectURL(); result != tc.expected {
+			t.Errorf(`Unexpected permanent redirect URL for %v, got %q instead of %q`, tc.statusCodes, result, tc.expected)
+		}
+	}
+
+	if result := NewResponseHandler(nil, errors.New("some error")).PermanentRedirectURL(); result != "" {
+		t.Errorf(`A failed request should not have a permanent redirect URL, got %q`, result)
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// List of supported actions.
+const (
+	ActionRead        = "read"
+	ActionStar        = "star"
+	ActionTag         = "tag"
+	ActionIntegration = "integration"
+)
+
+// Action is an operation applied to the entries matching an action rule.
+type Action struct {
+	Name string
+
+	// Argument is the tag name for "tag:<name>" or the integration name for "integration:<name>".
+	// A bare "integration" action sends the entry to all enabled integrations.
+	Argument string
+}
+
+func (a Action) String() string {
+	if a.Argument == "" {
+		return a.Name
+	}
+	return a.Name + ":" + a.Argument
+}
+
+// ActionRules is a list of action rules, usually one per line of the user input.
+type ActionRules []*ActionRule
+
+// ActionRule associates a rule with the actions to perform when it matches:
+//
+//	title~=(?i)release => star, tag:releases
+//	author==Bot => read
+//	url~=github\.com => integration:wallabag
+type ActionRule struct {
+	*Rule
+	Actions []Action
+}
+
+// ParseActionRules parses the given action rules, one rule per line.
+func ParseActionRules(input string) (ActionRules, error) {
+	var rules ActionRules
+
+	for i, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule, err := parseActionRule(line)
+		if err != nil {
+			return nil, &ParseError{Line: i + 1, Rule: line, Err: err}
+		}
+
+		rule.Line = i + 1
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// ValidateActionRules returns an error if the given action rules cannot be parsed.
+func ValidateActionRules(input string) error {
+	_, err := ParseActionRules(input)
+	return err
+}
+
+// Match returns the actions of all rules matching the entry, without duplicates.
+func (r ActionRules) Match(entry *model.Entry) []Action {
+	var actions []Action
+	for _, rule := range r {
+		if !rule.Match(entry, false) {
+			continue
+		}
+
+		for _, action := range rule.Actions {
+			if !slices.Contains(actions, action) {
+				actions = append(actions, action)
+			}
+		}
+	}
+	return actions
+}
+
+// Integrations returns the names of the integrations explicitly referenced by the rules.
+func (r ActionRules) Integrations() []string {
+	var names []string
+	for _, rule := range r {
+		for _, action := range rule.Actions {
+			if action.Name == ActionIntegration && action.Argument != "" && !slices.Contains(names, action.Argument) {
+				names = append(names, action.Argument)
+			}
+		}
+	}
+	return names
+}
+
+func parseActionRule(line string) (*ActionRule, error) {
+	separator := strings.LastIndex(line, "=>")
+	if separator == -1 {
+		return nil, errors.New(`missing "=>" between conditions and actions`)
+	}
+
+	conditions := strings.TrimSpace(line[:separator])
+	if conditions == "" {
+		return nil, errors.New("missing conditions")
+	}
+
+	rule, err := parseRule(conditions)
+	if err != nil {
+		return nil, err
+	}
+
+	actionRule := &ActionRule{Rule: rule}
+	for _, part := range strings.Split(line[separator+2:], ",") {
+		action, err := parseAction(strings.TrimSpace(part))
+		if err != nil {
+			return nil, err
+		}
+		actionRule.Actions = append(actionRule.Actions, action)
+	}
+
+	return actionRule, nil
+}
+
+func parseAction(input string) (Action, error) {
+	name, argument, _ := strings.Cut(input, ":")
+	action := Action{
+		Name:     strings.ToLower(strings.TrimSpace(name)),
+		Argument: strings.TrimSpace(argument),
+	}
+
+	switch action.Name {
+	case "":
+		return action, errors.New("missing action")
+	case ActionRead, ActionStar:
+		if action.Argument != "" {
+			return action, fmt.Errorf("action %q does not take an argument", action.Name)
+		}
+	case ActionTag:
+		if action.Argument == "" {
+			return action, errors.New(`missing tag name, expected "tag:<name>"`)
+		}
+	case ActionIntegration:
+		action.Argument = strings.ToLower(action.Argument)
+	default:
+		return action, fmt.Errorf("unknown action %q", action.Name)
+	}
+
+	return action, nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"reflect"
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestParseValidActionRules(t *testing.T) {
+	scenarios := []string{
+		"",
+		"# comment",
+		"title~=(?i)release => star",
+		"author==Bot => read",
+		"tag=golang => tag:go, star",
+		"(?i)example => integration",
+		"url~=github\\.com && title!~draft => integration:wallabag",
+		"title==A || title==B => READ",
+	}
+
+	for _, input := range scenarios {
+		if err := ValidateActionRules(input); err != nil {
+			t.Errorf(`Action rules %q should be valid, got %v`, input, err)
+		}
+	}
+}
+
+func TestParseInvalidActionRules(t *testing.T) {
+	scenarios := []string{
+		"title==A",
+		"=> star",
+		"title==A =>",
+		"title==A => star,",
+		"title==A => delete",
+		"title==A => tag",
+		"title==A => tag:",
+		"title==A => read:now",
+		"title~=[ => star",
+		"titel==A => star",
+	}
+
+	for _, input := range scenarios {
+		if err := ValidateActionRules(input); err == nil {
+			t.Errorf(`Action rules %q should be invalid`, input)
+		}
+	}
+}
+
+func TestActionRulesMatch(t *testing.T) {
+	rules, err := ParseActionRules("title~=(?i)release => star, tag:releases\nauthor==Bot => read, star\ntag=ads => integration:Wallabag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := rules.Match(&model.Entry{Title: "New release", Author: "Bot"})
+	expected := []Action{
+		{Name: ActionStar},
+		{Name: ActionTag, Argument: "releases"},
+		{Name: ActionRead},
+	}
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf(`Unexpected actions, got %v instead of %v`, actions, expected)
+	}
+
+	if actions := rules.Match(&model.Entry{Title: "Something else"}); len(actions) != 0 {
+		t.Errorf(`No action should match, got %v`, actions)
+	}
+
+	if names := rules.Integrations(); !reflect.DeepEqual(names, []string{"wallabag"}) {
+		t.Errorf(`Unexpected integrations, got %v`, names)
+	}
+}
@@ -0,0 +1,375 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package filter implements the rule language used by block and keep lists.
+//
+// Rules are written one per line. A line is either a plain regular expression,
+// matched against the entry URL, title, author and tags (legacy syntax), or a
+// list of conditions targeting a specific field:
+//
+//	title~=(?i)sponsored
+//	author==Bot
+//	tag=ads
+//	date>30d
+//	url~=example\.org && title!~(?i)release
+//
+// Conditions joined with "&&" must all match, conditions joined with "||"
+// need only one match ("&&" binds tighter than "||"). A connector is only
+// recognized when it is followed by another condition, so values may contain
+// "&&" and "||", for example in a regular expression like title~=(?i)foo||bar.
+// A rule set matches an entry as soon as one of its lines matches. Empty
+// lines and lines starting with "#" are ignored.
+//
+// Action rules use the same conditions, followed by "=>" and a comma separated
+// list of actions to perform on matching entries:
+//
+//	title~=(?i)release => star, tag:releases
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// List of supported fields.
+const (
+	FieldURL     = "url"
+	FieldTitle   = "title"
+	FieldAuthor  = "author"
+	FieldContent = "content"
+	FieldTag     = "tag"
+	FieldDate    = "date"
+)
+
+// List of supported operators.
+const (
+	OperatorEqual          = "=="
+	OperatorEqualShort     = "="
+	OperatorNotEqual       = "!="
+	OperatorMatch          = "~="
+	OperatorNotMatch       = "!~"
+	OperatorLessThan       = "<"
+	OperatorLessOrEqual    = "<="
+	OperatorGreaterThan    = ">"
+	OperatorGreaterOrEqual = ">="
+)
+
+var (
+	conditionRegex     = regexp.MustCompile(`^(url|title|author|content|tag|date)\s*(==|!=|~=|!~|<=|>=|=|<|>)\s*(.*)$`)
+	unknownFieldRegex  = regexp.MustCompile(`^([a-z_]+)\s*(==|!=|~=|!~|<=|>=)`)
+	durationRegex      = regexp.MustCompile(`^(\d+)\s*(m|h|d|w)$`)
+	nextConditionRegex = regexp.MustCompile(`^\s*((url|title|author|content|tag|date)\s*(==|!=|~=|!~|<=|>=|=|<|>)|[a-z_]+\s*(==|!=|~=|!~|<=|>=))`)
+)
+
+// Rules is a list of rules, usually one per line of the user input.
+type Rules []*Rule
+
+// Rule is a single line of the rule set.
+type Rule struct {
+	// Raw is the original text of the rule, used for logging.
+	Raw string
+
+	// Line is the line number of the rule in the original input.
+	Line int
+
+	// groups are evaluated with a OR, conditions inside a group with a AND.
+	groups [][]*condition
+
+	// legacy is set when the rule is a plain regex matched against all fields.
+	legacy *regexp.Regexp
+}
+
+type condition struct {
+	field    string
+	operator string
+	value    string
+	pattern  *regexp.Regexp
+	age      time.Duration
+}
+
+// ParseError is returned when a rule cannot be parsed.
+type ParseError struct {
+	Line int
+	Rule string
+	Err  error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("filter: invalid rule at line %d (%q): %v", e.Line, e.Rule, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Parse parses the given rules, one rule per line.
+func Parse(input string) (Rules, error) {
+	var rules Rules
+
+	for i, line := range strings.Split(input, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		rule, err := parseRule(line)
+		if err != nil {
+			return nil, &ParseError{Line: i + 1, Rule: line, Err: err}
+		}
+
+		rule.Line = i + 1
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// Validate returns an error if the given rules cannot be parsed.
+func Validate(input string) error {
+	_, err := Parse(input)
+	return err
+}
+
+// Match returns the first rule matching the entry, or nil if none matches.
+//
+// Legacy rules also look at the entry content when matchContent is true.
+func (r Rules) Match(entry *model.Entry, matchContent bool) *Rule {
+	for _, rule := range r {
+		if rule.Match(entry, matchContent) {
+			return rule
+		}
+	}
+	return nil
+}
+
+// Match returns true if the entry matches the rule.
+func (r *Rule) Match(entry *model.Entry, matchContent bool) bool {
+	if r.legacy != nil {
+		return r.legacy.MatchString(entry.URL) ||
+			r.legacy.MatchString(entry.Title) ||
+			r.legacy.MatchString(entry.Author) ||
+			slices.ContainsFunc(entry.Tags, r.legacy.MatchString) ||
+			(matchContent && r.legacy.MatchString(entry.Content))
+	}
+
+	for _, group := range r.groups {
+		if matchAll(group, entry) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (r *Rule) String() string {
+	return r.Raw
+}
+
+func matchAll(conditions []*condition, entry *model.Entry) bool {
+	for _, cond := range conditions {
+		if !cond.match(entry) {
+			return false
+		}
+	}
+	return true
+}
+
+func parseRule(line string) (*Rule, error) {
+	rule := &Rule{Raw: line}
+
+	if !conditionRegex.MatchString(line) {
+		if matches := unknownFieldRegex.FindStringSubmatch(line); matches != nil {
+			return nil, fmt.Errorf("unknown field %q", matches[1])
+		}
+
+		pattern, err := regexp.Compile(line)
+		if err != nil {
+			return nil, err
+		}
+
+		rule.legacy = pattern
+		return rule, nil
+	}
+
+	for _, alternative := range splitConditions(line, "||") {
+		var group []*condition
+
+		for _, part := range splitConditions(alternative, "&&") {
+			cond, err := parseCondition(strings.TrimSpace(part))
+			if err != nil {
+				return nil, err
+			}
+			group = append(group, cond)
+		}
+
+		rule.groups = append(rule.groups, group)
+	}
+
+	return rule, nil
+}
+
+// splitConditions splits the input on the given connector, only when the connector is followed by
+// another condition. The connector is otherwise part of the value of the previous condition.
+func splitConditions(input, connector string) []string {
+	var parts []string
+
+	start := 0
+	for offset := 0; offset < len(input); {
+		index := strings.Index(input[offset:], connector)
+		if index < 0 {
+			break
+		}
+
+		index += offset
+		next := index + len(connector)
+		if nextConditionRegex.MatchString(input[next:]) {
+			parts = append(parts, input[start:index])
+			start = next
+		}
+		offset = next
+	}
+
+	return append(parts, input[start:])
+}
+
+func parseCondition(input string) (*condition, error) {
+	matches := conditionRegex.FindStringSubmatch(input)
+	if matches == nil {
+		if matches := unknownFieldRegex.FindStringSubmatch(input); matches != nil {
+			return nil, fmt.Errorf("unknown field %q", matches[1])
+		}
+		return nil, fmt.Errorf("invalid condition %q", input)
+	}
+
+	cond := &condition{
+		field:    matches[1],
+		operator: matches[2],
+		value:    strings.TrimSpace(matches[3]),
+	}
+
+	if cond.value == "" {
+		return nil, fmt.Errorf("missing value for field %q", cond.field)
+	}
+
+	switch cond.operator {
+	case OperatorMatch, OperatorNotMatch:
+		if cond.field == FieldDate {
+			return nil, fmt.Errorf("operator %q is not supported for field %q", cond.operator, cond.field)
+		}
+
+		pattern, err := regexp.Compile(cond.value)
+		if err != nil {
+			return nil, err
+		}
+		cond.pattern = pattern
+	case OperatorLessThan, OperatorLessOrEqual, OperatorGreaterThan, OperatorGreaterOrEqual:
+		if cond.field != FieldDate {
+			return nil, fmt.Errorf("operator %q is only supported for field %q", cond.operator, FieldDate)
+		}
+
+		age, err := parseAge(cond.value)
+		if err != nil {
+			return nil, err
+		}
+		cond.age = age
+	default:
+		if cond.field == FieldDate {
+			return nil, fmt.Errorf("operator %q is not supported for field %q", cond.operator, cond.field)
+		}
+	}
+
+	return cond, nil
+}
+
+// parseAge parses durations like "45m", "12h", "30d" or "2w".
+func parseAge(input string) (time.Duration, error) {
+	matches := durationRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid duration %q, expected a number followed by m, h, d or w", input)
+	}
+
+	value, err := strconv.Atoi(matches[1])
+	if err != nil {
+		return 0, err
+	}
+
+	unit := time.Minute
+	switch matches[2] {
+	case "h":
+		unit = time.Hour
+	case "d":
+		unit = 24 * time.Hour
+	case "w":
+		unit = 7 * 24 * time.Hour
+	}
+
+	return time.Duration(value) * unit, nil
+}
+
+func (c *condition) match(entry *model.Entry) bool {
+	switch c.field {
+	case FieldDate:
+		return c.matchAge(time.Since(entry.Date))
+	case FieldTag:
+		return c.matchTags(entry.Tags)
+	case FieldURL:
+		return c.matchString(entry.URL)
+	case FieldTitle:
+		return c.matchString(entry.Title)
+	case FieldAuthor:
+		return c.matchString(entry.Author)
+	case FieldContent:
+		return c.matchString(entry.Content)
+	}
+	return false
+}
+
+func (c *condition) matchString(value string) bool {
+	switch c.operator {
+	case OperatorEqual, OperatorEqualShort:
+		return value == c.value
+	case OperatorNotEqual:
+		return value != c.value
+	case OperatorMatch:
+		return c.pattern.MatchString(value)
+	case OperatorNotMatch:
+		return !c.pattern.MatchString(value)
+	}
+	return false
+}
+
+// matchTags returns true if any tag matches for positive operators, or if no tag matches for negative operators.
+func (c *condition) matchTags(tags []string) bool {
+	switch c.operator {
+	case OperatorEqual, OperatorEqualShort:
+		return slices.Contains(tags, c.value)
+	case OperatorNotEqual:
+		return !slices.Contains(tags, c.value)
+	case OperatorMatch:
+		return slices.ContainsFunc(tags, c.pattern.MatchString)
+	case OperatorNotMatch:
+		return !slices.ContainsFunc(tags, c.pattern.MatchString)
+	}
+	return false
+}
+
+// matchAge compares the age of the entry: "date<30d" matches entries published less than 30 days ago.
+func (c *condition) matchAge(age time.Duration) bool {
+	switch c.operator {
+	case OperatorLessThan:
+		return age < c.age
+	case OperatorLessOrEqual:
+		return age <= c.age
+	case OperatorGreaterThan:
+		return age > c.age
+	case OperatorGreaterOrEqual:
+		return age >= c.age
+	}
+	return false
+}
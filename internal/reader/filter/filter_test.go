@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package filter // import "miniflux.app/v2/internal/reader/filter"
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestParseValidRules(t *testing.T) {
+	scenarios := []string{
+		"",
+		"(?i)example",
+		"title~=(?i)sponsored",
+		"author==Bot",
+		"author = Bot",
+		"tag=ads",
+		"tag!~^news$",
+		"date<30d",
+		"date>=12h",
+		"url~=example\\.org && title!~(?i)release",
+		"title==A || title==B",
+		"title~=(?i)foo||bar",
+		"title==A && foo",
+		"# comment\n\ntitle==A\r\ncontent~=foo",
+	}
+
+	for _, input := range scenarios {
+		if err := Validate(input); err != nil {
+			t.Errorf(`Rules %q should be valid, got %v`, input, err)
+		}
+	}
+}
+
+func TestParseInvalidRules(t *testing.T) {
+	scenarios := []string{
+		"[",
+		"title~=[",
+		"date<30y",
+		"date==30d",
+		"date~=30d",
+		"title<30d",
+		"titel~=foo",
+		"title==",
+		"title==A && foo==B",
+		"title==A || date~=1d",
+	}
+
+	for _, input := range scenarios {
+		if err := Validate(input); err == nil {
+			t.Errorf(`Rules %q should be invalid`, input)
+		}
+	}
+}
+
+func TestParseErrorLineNumber(t *testing.T) {
+	_, err := Parse("title==A\n\ntitle~=[")
+
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf(`Expected a ParseError, got %v`, err)
+	}
+
+	if parseErr.Line != 3 {
+		t.Errorf(`Unexpected line number, got %d instead of 3`, parseErr.Line)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	entry := &model.Entry{
+		URL:     "https://example.org/sponsored-post",
+		Title:   "Sponsored: Some Product",
+		Author:  "Bot",
+		Content: "Buy now",
+		Tags:    []string{"ads", "shopping"},
+		Date:    time.Now().Add(-48 * time.Hour),
+	}
+
+	scenarios := []struct {
+		rules        string
+		matchContent bool
+		expected     bool
+	}{
+		{"(?i)example", false, true},
+		{"(?i)buy", false, false},
+		{"(?i)buy", true, true},
+		{"(?i)shopping", false, true},
+		{"title~=(?i)sponsored", false, true},
+		{"title~=(?i)release", false, false},
+		{"title!~(?i)release", false, true},
+		{"author==Bot", false, true},
+		{"author==bot", false, false},
+		{"author!=Bot", false, false},
+		{"tag=ads", false, true},
+		{"tag=news", false, false},
+		{"tag!=news", false, true},
+		{"tag~=^shop", false, true},
+		{"tag!~^shop", false, false},
+		{"content~=(?i)buy", false, true},
+		{"date<3d", false, true},
+		{"date>3d", false, false},
+		{"date>=1d", false, true},
+		{"date<=1d", false, false},
+		{"url~=example && author==Bot", false, true},
+		{"url~=example && author==Someone", false, false},
+		{"author==Someone || tag=ads", false, true},
+		{"author==Someone || tag=news", false, false},
+		{"author==Someone\ntag=ads", false, true},
+		{"title~=^Other||Title$", false, true},
+		{"url~=\\?a=1&&b=2", false, false},
+		{"title~=Other||None && author==Someone", false, false},
+		{"title~=Title||bar && author==Bot", false, true},
+	}
+
+	for _, tc := range scenarios {
+		rules, err := Parse(tc.rules)
+		if err != nil {
+			t.Fatalf(`Unable to parse rules %q: %v`, tc.rules, err)
+		}
+
+		result := rules.Match(entry, tc.matchContent) != nil
+		if result != tc.expected {
+			t.Errorf(`Unexpected result for rules %q, got %v instead of %v`, tc.rules, result, tc.expected)
+		}
+	}
+}
+
+func TestMatchReturnsMatchingRule(t *testing.T) {
+	rules, err := Parse("title==A\ntitle==B")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rule := rules.Match(&model.Entry{Title: "B"}, false)
+	if rule == nil {
+		t.Fatal(`A rule should match`)
+	}
+
+	if rule.Line != 2 || rule.String() != "title==B" {
+		t.Errorf(`Unexpected rule, got line %d and %q`, rule.Line, rule.String())
+	}
+}
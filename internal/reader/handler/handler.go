@@ -6,7 +6,9 @@ package handler // import "miniflux.app/v2/internal/reader/handler"
 import (
 	"bytes"
 	"errors"
+	"io"
 	"log/slog"
+	"time"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/integration"
@@ -14,6 +16,7 @@ import (
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/fetcher"
 	"miniflux.app/v2/internal/reader/icon"
+	"miniflux.app/v2/internal/reader/pagefeed"
 	"miniflux.app/v2/internal/reader/parser"
 	"miniflux.app/v2/internal/reader/processor"
 	"miniflux.app/v2/internal/storage"
@@ -36,7 +39,12 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
 
-	if !store.CategoryIDExists(userID, feedCreationRequest.CategoryID) {
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
+	if storeErr != nil {
+		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
@@ -44,7 +52,7 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 		return nil, locale.NewLocalizedErrorWrapper(ErrDuplicatedFeed, "error.duplicated_feed")
 	}
 
-	subscription, parseErr := parser.ParseFeed(feedCreationRequest.FeedURL, feedCreationRequest.Content)
+	subscription, parseErr := parseFeed(feedCreationRequest.FeedURL, feedCreationRequest.Content, &feedCreationRequest.PageSelectors)
 	if parseErr != nil {
 		return nil, locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
 	}
@@ -65,11 +73,13 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 	subscription.BlocklistRules = feedCreationRequest.BlocklistRules
 	subscription.KeeplistRules = feedCreationRequest.KeeplistRules
 	subscription.UrlRewriteRules = feedCreationRequest.UrlRewriteRules
+	subscription.PageSelectors = feedCreationRequest.PageSelectors
 	subscription.EtagHeader = feedCreationRequest.ETag
 	subscription.LastModifiedHeader = feedCreationRequest.LastModified
+	topicURL := subscription.FeedURL
 	subscription.FeedURL = feedCreationRequest.FeedURL
 	subscription.DisableHTTP2 = feedCreationRequest.DisableHTTP2
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.Category = category
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(store, subscription, user, true)
@@ -78,6 +88,9 @@ func CreateFeedFromSubscriptionDiscovery(store *storage.Storage, userID int64, f
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
 
+	processor.MarkDuplicateEntries(store, user, subscription.Entries)
+	saveWebSubHub(store, subscription, subscription.HubURL, topicURL)
+
 	slog.Debug("Created feed",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", subscription.ID),
@@ -117,7 +130,12 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
 
-	if !store.CategoryIDExists(userID, feedCreationRequest.CategoryID) {
+	category, storeErr := store.Category(userID, feedCreationRequest.CategoryID)
+	if storeErr != nil {
+		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if category == nil {
 		return nil, locale.NewLocalizedErrorWrapper(ErrCategoryNotFound, "error.category_not_found")
 	}
 
@@ -149,7 +167,7 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		return nil, locale.NewLocalizedErrorWrapper(ErrDuplicatedFeed, "error.duplicated_feed")
 	}
 
-	subscription, parseErr := parser.ParseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody))
+	subscription, parseErr := parseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody), &feedCreationRequest.PageSelectors)
 	if parseErr != nil {
 		return nil, locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
 	}
@@ -170,10 +188,12 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 	subscription.BlocklistRules = feedCreationRequest.BlocklistRules
 	subscription.KeeplistRules = feedCreationRequest.KeeplistRules
 	subscription.UrlRewriteRules = feedCreationRequest.UrlRewriteRules
+	subscription.PageSelectors = feedCreationRequest.PageSelectors
 	subscription.EtagHeader = responseHandler.ETag()
 	subscription.LastModifiedHeader = responseHandler.LastModified()
+	topicURL := subscription.FeedURL
 	subscription.FeedURL = responseHandler.EffectiveURL()
-	subscription.WithCategoryID(feedCreationRequest.CategoryID)
+	subscription.Category = category
 	subscription.CheckedNow()
 
 	processor.ProcessFeedEntries(store, subscription, user, true)
@@ -182,6 +202,9 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 		return nil, locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
 	}
 
+	processor.MarkDuplicateEntries(store, user, subscription.Entries)
+	saveWebSubHub(store, subscription, subscription.HubURL, topicURL)
+
 	slog.Debug("Created feed",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", subscription.ID),
@@ -200,6 +223,17 @@ func CreateFeed(store *storage.Storage, userID int64, feedCreationRequest *model
 
 // RefreshFeed refreshes a feed.
 func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool) *locale.LocalizedErrorWrapper {
+	return refreshFeed(store, userID, feedID, forceRefresh, false)
+}
+
+// RefreshFeedWithRetry refreshes a feed from a background job.
+// Transient fetch errors are not recorded on the feed when the job will be retried,
+// only the last attempt counts towards the parsing error limit.
+func RefreshFeedWithRetry(store *storage.Storage, userID, feedID int64, willRetry bool) *locale.LocalizedErrorWrapper {
+	return refreshFeed(store, userID, feedID, false, willRetry)
+}
+
+func refreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh, willRetry bool) (refreshErr *locale.LocalizedErrorWrapper) {
 	slog.Debug("Begin feed refresh process",
 		slog.Int64("user_id", userID),
 		slog.Int64("feed_id", feedID),
@@ -220,6 +254,14 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return locale.NewLocalizedErrorWrapper(ErrFeedNotFound, "error.feed_not_found")
 	}
 
+	refresh := model.NewFeedRefresh(originalFeed)
+	defer func() {
+		if refreshErr != nil {
+			refresh.Error = refreshErr.Translate(user.Language)
+		}
+		recordFeedRefresh(store, refresh)
+	}()
+
 	weeklyEntryCount := 0
 	newTTL := 0
 	if config.Opts.PollingScheduler() == model.SchedulerEntryFrequency {
@@ -231,7 +273,7 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 	}
 
 	originalFeed.CheckedNow()
-	originalFeed.ScheduleNextCheck(weeklyEntryCount, newTTL)
+	originalFeed.ScheduleNextCheck(weeklyEntryCount, newTTL, 0)
 
 	requestBuilder := fetcher.NewRequestBuilder()
 	requestBuilder.WithUsernameAndPassword(originalFeed.Username, originalFeed.Password)
@@ -249,16 +291,35 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		requestBuilder.WithLastModified(originalFeed.LastModifiedHeader)
 	}
 
+	fetchStart := time.Now()
 	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(originalFeed.FeedURL))
 	defer responseHandler.Close()
 
+	refresh.StatusCode = responseHandler.StatusCode()
+	refresh.WithDuration(fetchStart)
+
 	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
 		slog.Warn("Unable to fetch feed", slog.String("feed_url", originalFeed.FeedURL), slog.Any("error", localizedError.Error()))
+		if willRetry && fetcher.IsTransientError(localizedError.Error()) {
+			return localizedError
+		}
+		if retryAfter := responseHandler.RetryAfter(); retryAfter > 0 {
+			originalFeed.PostponeNextCheck(retryAfter)
+		}
 		originalFeed.WithTranslatedErrorMessage(localizedError.Translate(user.Language))
-		store.UpdateFeedError(originalFeed)
+		if errors.Is(localizedError.Error(), fetcher.ErrResourceGone) {
+			disableGoneFeed(store, originalFeed)
+		} else {
+			store.UpdateFeedError(originalFeed)
+		}
 		return localizedError
 	}
 
+	// The caching headers are also sent with 304 responses, the next check is rescheduled in both cases.
+	cacheMaxAge := int(responseHandler.CacheMaxAge().Minutes())
+	originalFeed.ScheduleNextCheck(weeklyEntryCount, newTTL, cacheMaxAge)
+	postponeNextCheckForWebSub(store, originalFeed)
+
 	if store.AnotherFeedURLExists(userID, originalFeed.ID, responseHandler.EffectiveURL()) {
 		localizedError := locale.NewLocalizedErrorWrapper(ErrDuplicatedFeed, "error.duplicated_feed")
 		originalFeed.WithTranslatedErrorMessage(localizedError.Translate(user.Language))
@@ -266,6 +327,8 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		return localizedError
 	}
 
+	migrateFeedURL(store, originalFeed, responseHandler.PermanentRedirectURL(), refresh)
+
 	if ignoreHTTPCache || responseHandler.IsModified(originalFeed.EtagHeader, originalFeed.LastModifiedHeader) {
 		slog.Debug("Feed modified",
 			slog.Int64("user_id", userID),
@@ -278,7 +341,10 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 			return localizedError
 		}
 
-		updatedFeed, parseErr := parser.ParseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody))
+		refresh.Size = int64(len(responseBody))
+		refresh.WithDuration(fetchStart)
+
+		updatedFeed, parseErr := parseFeed(responseHandler.EffectiveURL(), bytes.NewReader(responseBody), &originalFeed.PageSelectors)
 		if parseErr != nil {
 			localizedError := locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
 
@@ -294,7 +360,8 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		// If the feed has a TTL defined, we use it to make sure we don't check it too often.
 		newTTL = updatedFeed.TTL
 		// Set the next check at with updated arguments.
-		originalFeed.ScheduleNextCheck(weeklyEntryCount, newTTL)
+		originalFeed.ScheduleNextCheck(weeklyEntryCount, newTTL, cacheMaxAge)
+		postponeNextCheckForWebSub(store, originalFeed)
 		slog.Debug("Updated next check date",
 			slog.Int64("user_id", userID),
 			slog.Int64("feed_id", feedID),
@@ -303,28 +370,13 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 		)
 
 		originalFeed.Entries = updatedFeed.Entries
-		processor.ProcessFeedEntries(store, originalFeed, user, forceRefresh)
-
-		// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries). Unless it is forced to refresh
-		updateExistingEntries := forceRefresh || !originalFeed.Crawler
-		newEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries)
-		if storeErr != nil {
-			localizedError := locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
-			originalFeed.WithTranslatedErrorMessage(localizedError.Translate(user.Language))
-			store.UpdateFeedError(originalFeed)
+		newEntriesCount, localizedError := refreshFeedEntries(store, user, originalFeed, forceRefresh)
+		if localizedError != nil {
 			return localizedError
 		}
+		refresh.NewEntries = newEntriesCount
 
-		userIntegrations, intErr := store.Integration(userID)
-		if intErr != nil {
-			slog.Error("Fetching integrations failed; the refresh process will go on, but no integrations will run this time",
-				slog.Int64("user_id", userID),
-				slog.Int64("feed_id", feedID),
-				slog.Any("error", intErr),
-			)
-		} else if userIntegrations != nil && len(newEntries) > 0 {
-			go integration.PushEntries(originalFeed, newEntries, userIntegrations)
-		}
+		saveWebSubHub(store, originalFeed, updatedFeed.HubURL, updatedFeed.FeedURL)
 
 		// We update caching headers only if the feed has been modified,
 		// because some websites don't return the same headers when replying with a 304.
@@ -357,6 +409,126 @@ func RefreshFeed(store *storage.Storage, userID, feedID int64, forceRefresh bool
 	return nil
 }
 
+// refreshFeedEntries stores the entries of the feed, then runs the user actions and the integrations on the new entries.
+// It returns the number of new entries.
+func refreshFeedEntries(store *storage.Storage, user *model.User, originalFeed *model.Feed, forceRefresh bool) (int, *locale.LocalizedErrorWrapper) {
+	processor.ProcessFeedEntries(store, originalFeed, user, forceRefresh)
+
+	// We don't update existing entries when the crawler is enabled (we crawl only inexisting entries). Unless it is forced to refresh
+	updateExistingEntries := forceRefresh || !originalFeed.Crawler
+	newEntries, storeErr := store.RefreshFeedEntries(originalFeed.UserID, originalFeed.ID, originalFeed.Entries, updateExistingEntries)
+	if storeErr != nil {
+		localizedError := locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+		originalFeed.WithTranslatedErrorMessage(localizedError.Translate(user.Language))
+		store.UpdateFeedError(originalFeed)
+		return 0, localizedError
+	}
+
+	newEntriesCount := len(newEntries)
+
+	userIntegrations, intErr := store.Integration(user.ID)
+	if intErr != nil {
+		slog.Error("Fetching integrations failed; the refresh process will go on, but no integrations will run this time",
+			slog.Int64("user_id", user.ID),
+			slog.Int64("feed_id", originalFeed.ID),
+			slog.Any("error", intErr),
+		)
+		userIntegrations = nil
+	}
+
+	newEntries = processor.MarkDuplicateEntries(store, user, newEntries)
+	processor.ApplyEntryActions(store, user, newEntries, userIntegrations)
+
+	if userIntegrations != nil && len(newEntries) > 0 {
+		go integration.PushEntries(originalFeed, newEntries, userIntegrations)
+	}
+
+	return newEntriesCount, nil
+}
+
+// migrateFeedURL updates the feed URL once the feed has been permanently redirected to the same URL enough times in a row.
+// The feed URL is not updated if another subscription of the user already uses the new URL.
+func migrateFeedURL(store *storage.Storage, feed *model.Feed, redirectURL string, refresh *model.FeedRefresh) {
+	threshold := config.Opts.PermanentRedirectThreshold()
+	if threshold <= 0 || feed.TrackPermanentRedirect(redirectURL) < threshold {
+		return
+	}
+
+	if store.AnotherFeedURLExists(feed.UserID, feed.ID, redirectURL) {
+		slog.Warn("Feed permanently redirected to another subscription, keeping the feed URL",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("feed_url", feed.FeedURL),
+			slog.String("redirect_url", redirectURL),
+		)
+		return
+	}
+
+	slog.Info("Feed permanently redirected, updating the feed URL",
+		slog.Int64("user_id", feed.UserID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("old_feed_url", feed.FeedURL),
+		slog.String("new_feed_url", redirectURL),
+	)
+
+	feed.FeedURL = redirectURL
+	feed.TrackPermanentRedirect("")
+	refresh.NewFeedURL = redirectURL
+}
+
+// disableGoneFeed disables a feed removed permanently by its publisher, instead of retrying it until the error limit is reached.
+// The reason is kept in the feed error message and the user is notified through the notification integrations.
+func disableGoneFeed(store *storage.Storage, feed *model.Feed) {
+	slog.Info("Feed removed permanently by the publisher, disabling the feed",
+		slog.Int64("user_id", feed.UserID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("feed_url", feed.FeedURL),
+	)
+
+	feed.Disabled = true
+	if err := store.UpdateFeed(feed); err != nil {
+		slog.Error("Unable to disable feed",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	userIntegrations, err := store.Integration(feed.UserID)
+	if err != nil {
+		slog.Error("Fetching integrations failed; the feed disabled notification will not be sent",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	go integration.NotifyFeedDisabled(feed, feed.ParsingErrorMsg, userIntegrations)
+}
+
+// parseFeed parses the feed, or generates it from the web page when the page selectors are defined.
+func parseFeed(baseURL string, r io.ReadSeeker, selectors *model.PageSelectors) (*model.Feed, error) {
+	if selectors.Item != "" {
+		if _, err := r.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+		return pagefeed.Parse(baseURL, r, selectors)
+	}
+	return parser.ParseFeed(baseURL, r)
+}
+
+func recordFeedRefresh(store *storage.Storage, refresh *model.FeedRefresh) {
+	if err := store.CreateFeedRefresh(refresh); err != nil {
+		slog.Error("Unable to record feed refresh",
+			slog.Int64("user_id", refresh.UserID),
+			slog.Int64("feed_id", refresh.FeedID),
+			slog.Any("error", err),
+		)
+	}
+}
+
 func checkFeedIcon(store *storage.Storage, requestBuilder *fetcher.RequestBuilder, feedID int64, websiteURL, feedIconURL string) {
 	if !store.HasIcon(feedID) {
 		iconFinder := icon.NewIconFinder(requestBuilder, websiteURL, feedIconURL)
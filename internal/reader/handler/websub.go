@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package handler // import "miniflux.app/v2/internal/reader/handler"
+
+import (
+	"bytes"
+	"log/slog"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/parser"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ProcessPushedFeed stores the content sent by a WebSub hub, the same way RefreshFeed does with the fetched content.
+func ProcessPushedFeed(store *storage.Storage, userID, feedID int64, body []byte) *locale.LocalizedErrorWrapper {
+	slog.Debug("Begin pushed feed process",
+		slog.Int64("user_id", userID),
+		slog.Int64("feed_id", feedID),
+	)
+
+	user, storeErr := store.UserByID(userID)
+	if storeErr != nil {
+		return locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	originalFeed, storeErr := store.FeedByID(userID, feedID)
+	if storeErr != nil {
+		return locale.NewLocalizedErrorWrapper(storeErr, "error.database_error", storeErr)
+	}
+
+	if originalFeed == nil {
+		return locale.NewLocalizedErrorWrapper(ErrFeedNotFound, "error.feed_not_found")
+	}
+
+	if originalFeed.Disabled {
+		return nil
+	}
+
+	updatedFeed, parseErr := parser.ParseFeed(originalFeed.FeedURL, bytes.NewReader(body))
+	if parseErr != nil {
+		return locale.NewLocalizedErrorWrapper(parseErr, "error.unable_to_parse_feed", parseErr)
+	}
+
+	originalFeed.Entries = updatedFeed.Entries
+	_, localizedError := refreshFeedEntries(store, user, originalFeed, false)
+	return localizedError
+}
+
+// saveWebSubHub keeps track of the hub advertised by the feed, the subscription itself is requested by the scheduler.
+func saveWebSubHub(store *storage.Storage, feed *model.Feed, hubURL, topicURL string) {
+	if !config.Opts.WebSub() {
+		return
+	}
+
+	var err error
+	if hubURL == "" {
+		err = store.RemoveWebSubSubscription(feed.ID)
+	} else {
+		err = store.SaveWebSubHub(feed.UserID, feed.ID, hubURL, topicURL)
+	}
+
+	if err != nil {
+		slog.Error("Unable to save WebSub hub",
+			slog.Int64("user_id", feed.UserID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("hub_url", hubURL),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// postponeNextCheckForWebSub polls feeds with an active WebSub subscription as rarely as possible, the hub sends the updates.
+func postponeNextCheckForWebSub(store *storage.Storage, feed *model.Feed) {
+	if config.Opts.WebSub() && store.HasActiveWebSubSubscription(feed.ID) {
+		feed.PostponeNextCheck(time.Duration(config.Opts.SchedulerEntryFrequencyMaxInterval()) * time.Minute)
+	}
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package hfeed // import "miniflux.app/v2/internal/reader/hfeed"
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/atom"
+)
+
+// isRoot returns true if the element is the root of a microformats2 object, i.e. it has a class name prefixed by "h-".
+func isRoot(s *goquery.Selection) bool {
+	for _, class := range strings.Fields(s.AttrOr("class", "")) {
+		if len(class) > 2 && strings.HasPrefix(class, "h-") {
+			return true
+		}
+	}
+	return false
+}
+
+// findItems returns the objects of the given type, ignoring those nested in another object of the same type.
+func findItems(s *goquery.Selection, class string) *goquery.Selection {
+	return s.Find("." + class).FilterFunction(func(_ int, item *goquery.Selection) bool {
+		return item.ParentsFiltered("."+class).Length() == 0
+	})
+}
+
+// findProperties returns the properties belonging to the object, the properties of nested objects are ignored.
+func findProperties(item *goquery.Selection, class string) *goquery.Selection {
+	root := item.Get(0)
+	return item.Find("." + class).FilterFunction(func(_ int, property *goquery.Selection) bool {
+		for parent := property.Parent(); parent.Length() > 0; parent = parent.Parent() {
+			if parent.Get(0) == root {
+				return true
+			}
+			if isRoot(parent) {
+				return false
+			}
+		}
+		return false
+	})
+}
+
+// textValue returns the value of a "p-*" property.
+func textValue(s *goquery.Selection) string {
+	switch s.Get(0).DataAtom {
+	case atom.Abbr, atom.Link:
+		if value, exists := s.Attr("title"); exists {
+			return strings.TrimSpace(value)
+		}
+	case atom.Data, atom.Input:
+		if value, exists := s.Attr("value"); exists {
+			return strings.TrimSpace(value)
+		}
+	case atom.Img, atom.Area:
+		if value, exists := s.Attr("alt"); exists {
+			return strings.TrimSpace(value)
+		}
+	}
+	return strings.Join(strings.Fields(s.Text()), " ")
+}
+
+// urlValue returns the value of a "u-*" property, the URL may be relative.
+func urlValue(s *goquery.Selection) string {
+	var attribute string
+	switch s.Get(0).DataAtom {
+	case atom.A, atom.Area, atom.Link:
+		attribute = "href"
+	case atom.Img, atom.Audio, atom.Video, atom.Source, atom.Iframe:
+		attribute = "src"
+	case atom.Object:
+		attribute = "data"
+	}
+
+	if value, exists := s.Attr(attribute); attribute != "" && exists {
+		return strings.TrimSpace(value)
+	}
+	return textValue(s)
+}
+
+// dateValue returns the value of a "dt-*" property.
+func dateValue(s *goquery.Selection) string {
+	switch s.Get(0).DataAtom {
+	case atom.Time, atom.Ins, atom.Del:
+		if value, exists := s.Attr("datetime"); exists {
+			return strings.TrimSpace(value)
+		}
+	}
+	return textValue(s)
+}
+
+// htmlValue returns the value of an "e-*" property.
+func htmlValue(s *goquery.Selection) string {
+	value, _ := s.Html()
+	return strings.TrimSpace(value)
+}
+
+// personName returns the name of a "p-author" property, which is either a plain text value or a nested h-card.
+func personName(s *goquery.Selection) string {
+	if s.HasClass("h-card") {
+		if name := findProperties(s, "p-name").First(); name.Length() > 0 {
+			return textValue(name)
+		}
+	}
+	return textValue(s)
+}
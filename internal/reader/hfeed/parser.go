@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package hfeed // import "miniflux.app/v2/internal/reader/hfeed"
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/urllib"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/charset"
+)
+
+// ErrFeedNotFound is returned when the document does not contain any h-feed or h-entry.
+var ErrFeedNotFound = errors.New("hfeed: no h-feed or h-entry found")
+
+// Parse returns a normalized feed struct from a HTML page carrying h-feed or h-entry microformats.
+func Parse(baseURL string, data io.Reader) (*model.Feed, error) {
+	htmlDocumentReader, err := charset.NewReader(data, "text/html")
+	if err != nil {
+		return nil, fmt.Errorf("hfeed: unable to read document: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(htmlDocumentReader)
+	if err != nil {
+		return nil, fmt.Errorf("hfeed: unable to parse document: %w", err)
+	}
+
+	root, items := findFeed(doc)
+	if root.Length() == 0 && items.Length() == 0 {
+		return nil, ErrFeedNotFound
+	}
+
+	return buildFeed(baseURL, doc, root, items), nil
+}
+
+// ContainsFeed returns true if the document carries an h-feed, or several top-level h-entry forming an implied feed.
+//
+// A single h-entry usually marks up a permalink page rather than a feed.
+func ContainsFeed(doc *goquery.Document) bool {
+	root, items := findFeed(doc)
+	return root.Length() > 0 || items.Length() > 1
+}
+
+// findFeed returns the first h-feed of the document and its entries.
+// Without h-feed, the top-level h-entry of the document form an implied feed and the returned root is empty.
+func findFeed(doc *goquery.Document) (root, items *goquery.Selection) {
+	root = findItems(doc.Selection, "h-feed").First()
+	if root.Length() > 0 {
+		return root, findItems(root, "h-entry")
+	}
+	return root, findItems(doc.Selection, "h-entry")
+}
+
+func buildFeed(baseURL string, doc *goquery.Document, root, items *goquery.Selection) *model.Feed {
+	feed := &model.Feed{
+		FeedURL: baseURL,
+		SiteURL: baseURL,
+	}
+
+	var feedAuthors []string
+	if root.Length() > 0 {
+		feed.Title = propertyText(root, "p-name")
+
+		if siteURL := propertyURL(baseURL, root, "u-url"); siteURL != "" {
+			feed.SiteURL = siteURL
+		}
+
+		for _, class := range []string{"u-photo", "u-logo"} {
+			if iconURL := propertyURL(baseURL, root, class); iconURL != "" {
+				feed.IconURL = iconURL
+				break
+			}
+		}
+
+		feedAuthors = authorNames(root)
+	}
+
+	// Fallback to the page title, and to the site URL if the title is empty.
+	if feed.Title == "" {
+		feed.Title = strings.TrimSpace(doc.Find("head title").First().Text())
+	}
+	if feed.Title == "" {
+		feed.Title = feed.SiteURL
+	}
+
+	items.Each(func(_ int, item *goquery.Selection) {
+		feed.Entries = append(feed.Entries, buildEntry(baseURL, item, feedAuthors))
+	})
+
+	return feed
+}
+
+func buildEntry(baseURL string, item *goquery.Selection, feedAuthors []string) *model.Entry {
+	entry := model.NewEntry()
+	entry.URL = propertyURL(baseURL, item, "u-url")
+	uid := propertyURL(baseURL, item, "u-uid")
+
+	// Fallback to the unique identifier, and to the page URL if the entry doesn't have a permalink.
+	if entry.URL == "" {
+		entry.URL = uid
+	}
+	if entry.URL == "" {
+		entry.URL = baseURL
+	}
+
+	// Populate the entry content.
+	if content := findProperties(item, "e-content").First(); content.Length() > 0 {
+		entry.Content = htmlValue(content)
+	}
+	if entry.Content == "" {
+		entry.Content = html.EscapeString(propertyText(item, "p-summary"))
+	}
+
+	// The entry name is optional, notes usually only have a content.
+	entry.Title = propertyText(item, "p-name")
+	if entry.Title == "" && entry.Content != "" {
+		entry.Title = sanitizer.TruncateHTML(entry.Content, 100)
+	}
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+
+	// Populate the entry date.
+	for _, class := range []string{"dt-published", "dt-updated"} {
+		if property := findProperties(item, class).First(); property.Length() > 0 {
+			value := dateValue(property)
+			if parsedDate, err := date.Parse(value); err != nil {
+				slog.Debug("Unable to parse date from h-entry",
+					slog.String("date", value),
+					slog.String("url", entry.URL),
+					slog.Any("error", err),
+				)
+			} else {
+				entry.Date = parsedDate
+				break
+			}
+		}
+	}
+	if entry.Date.IsZero() {
+		entry.Date = time.Now()
+	}
+
+	// Populate the entry author, the feed author is used when the entry doesn't have one.
+	authors := authorNames(item)
+	if len(authors) == 0 {
+		authors = feedAuthors
+	}
+	entry.Author = strings.Join(authors, ", ")
+
+	// Populate the entry enclosures.
+	findProperties(item, "u-photo").Each(func(_ int, property *goquery.Selection) {
+		photoURL, err := urllib.AbsoluteURL(baseURL, urlValue(property))
+		if err != nil || photoURL == "" {
+			return
+		}
+		if !slices.ContainsFunc(entry.Enclosures, func(enclosure *model.Enclosure) bool { return enclosure.URL == photoURL }) {
+			entry.Enclosures = append(entry.Enclosures, &model.Enclosure{URL: photoURL, MimeType: urllib.ImageMimeType(photoURL)})
+		}
+	})
+
+	// Populate the entry tags.
+	findProperties(item, "p-category").Each(func(_ int, property *goquery.Selection) {
+		if tag := textValue(property); tag != "" && !slices.Contains(entry.Tags, tag) {
+			entry.Tags = append(entry.Tags, tag)
+		}
+	})
+
+	// Generate a hash for the entry.
+	for _, value := range []string{uid, propertyURL(baseURL, item, "u-url"), entry.Content} {
+		if value != "" {
+			entry.Hash = crypto.Hash(value)
+			break
+		}
+	}
+	if entry.Hash == "" {
+		entry.Hash = crypto.Hash(item.Text())
+	}
+
+	return entry
+}
+
+// propertyText returns the value of the first "p-*" property of the object.
+func propertyText(item *goquery.Selection, class string) string {
+	if property := findProperties(item, class).First(); property.Length() > 0 {
+		return textValue(property)
+	}
+	return ""
+}
+
+// propertyURL returns the absolute value of the first "u-*" property of the object.
+func propertyURL(baseURL string, item *goquery.Selection, class string) string {
+	property := findProperties(item, class).First()
+	if property.Length() == 0 {
+		return ""
+	}
+
+	value := urlValue(property)
+	if value == "" {
+		return ""
+	}
+
+	absoluteURL, err := urllib.AbsoluteURL(baseURL, value)
+	if err != nil {
+		return ""
+	}
+	return absoluteURL
+}
+
+// authorNames returns the sorted names of the authors of the object, without duplicates.
+func authorNames(item *goquery.Selection) []string {
+	var names []string
+	findProperties(item, "p-author").Each(func(_ int, property *goquery.Selection) {
+		if name := personName(property); name != "" {
+			names = append(names, name)
+		}
+	})
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
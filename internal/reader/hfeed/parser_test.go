@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package hfeed // import "miniflux.app/v2/internal/reader/hfeed"
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseHFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<head><title>Page Title</title></head>
+		<body>
+			<div class="h-feed">
+				<h1 class="p-name">My Blog</h1>
+				<a class="u-url" href="/blog/">Home</a>
+				<a class="p-author h-card" href="/"><img class="u-photo" src="/me.jpg" alt=""><span class="p-name">Jane Doe</span></a>
+				<article class="h-entry">
+					<h2><a class="u-url p-name" href="/blog/first-post">First Post</a></h2>
+					<time class="dt-published" datetime="2024-01-02T10:00:00Z">January 2nd</time>
+					<div class="e-content"><p>Hello <b>world</b>!</p></div>
+					<img class="u-photo" src="/photo.jpg" alt="A photo">
+					<a class="p-category" href="/tags/go">go</a>
+					<a class="p-category" href="/tags/web">web</a>
+				</article>
+				<article class="h-entry">
+					<div class="p-author h-card"><span class="p-name">John Smith</span></div>
+					<div class="e-content">Just a note</div>
+					<a class="u-url" href="https://example.org/notes/2"><time class="dt-published" datetime="2024-01-03 12:30:00+01:00">Jan 3</time></a>
+				</article>
+			</div>
+		</body>
+	</html>`
+
+	feed, err := Parse("https://example.org/blog/", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "My Blog" {
+		t.Errorf("Incorrect title, got: %q", feed.Title)
+	}
+
+	if feed.FeedURL != "https://example.org/blog/" {
+		t.Errorf("Incorrect feed URL, got: %q", feed.FeedURL)
+	}
+
+	if feed.SiteURL != "https://example.org/blog/" {
+		t.Errorf("Incorrect site URL, got: %q", feed.SiteURL)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First Post" {
+		t.Errorf("Incorrect entry title, got: %q", entry.Title)
+	}
+
+	if entry.URL != "https://example.org/blog/first-post" {
+		t.Errorf("Incorrect entry URL, got: %q", entry.URL)
+	}
+
+	if entry.Content != "<p>Hello <b>world</b>!</p>" {
+		t.Errorf("Incorrect entry content, got: %q", entry.Content)
+	}
+
+	if !entry.Date.Equal(time.Date(2024, time.January, 2, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Incorrect entry date, got: %v", entry.Date)
+	}
+
+	if entry.Author != "Jane Doe" {
+		t.Errorf("Incorrect entry author, got: %q", entry.Author)
+	}
+
+	if len(entry.Enclosures) != 1 || entry.Enclosures[0].URL != "https://example.org/photo.jpg" || entry.Enclosures[0].MimeType != "image/jpeg" {
+		t.Errorf("Incorrect entry enclosures, got: %v", entry.Enclosures)
+	}
+
+	if len(entry.Tags) != 2 || entry.Tags[0] != "go" || entry.Tags[1] != "web" {
+		t.Errorf("Incorrect entry tags, got: %v", entry.Tags)
+	}
+
+	note := feed.Entries[1]
+	if note.Title != "Just a note" {
+		t.Errorf("Incorrect note title, got: %q", note.Title)
+	}
+
+	if note.URL != "https://example.org/notes/2" {
+		t.Errorf("Incorrect note URL, got: %q", note.URL)
+	}
+
+	if note.Author != "John Smith" {
+		t.Errorf("Incorrect note author, got: %q", note.Author)
+	}
+
+	if note.Date.Year() != 2024 || note.Date.Day() != 3 {
+		t.Errorf("Incorrect note date, got: %v", note.Date)
+	}
+
+	if entry.Hash == note.Hash {
+		t.Error("Entries should have different hashes")
+	}
+}
+
+func TestParseImpliedFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<head><title>Notes</title></head>
+		<body>
+			<article class="h-entry">
+				<p class="p-name">Entry with a nested reply</p>
+				<div class="u-in-reply-to h-cite"><a class="u-url p-name" href="https://example.com/other">Other</a></div>
+				<div class="h-entry"><span class="p-name">Nested</span></div>
+			</article>
+			<article class="h-entry"><p class="p-summary">Summary &lt;only&gt;</p></article>
+		</body>
+	</html>`
+
+	feed, err := Parse("https://example.org/notes", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Notes" {
+		t.Errorf("Incorrect title, got: %q", feed.Title)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf("Incorrect number of entries, got: %d", len(feed.Entries))
+	}
+
+	if feed.Entries[0].Title != "Entry with a nested reply" {
+		t.Errorf("Incorrect entry title, got: %q", feed.Entries[0].Title)
+	}
+
+	// The properties of nested objects don't belong to the entry.
+	if feed.Entries[0].URL != "https://example.org/notes" {
+		t.Errorf("Incorrect entry URL, got: %q", feed.Entries[0].URL)
+	}
+
+	if feed.Entries[1].Content != "Summary &lt;only&gt;" {
+		t.Errorf("Incorrect entry content, got: %q", feed.Entries[1].Content)
+	}
+}
+
+func TestParseDocumentWithoutMicroformats(t *testing.T) {
+	_, err := Parse("https://example.org/", strings.NewReader(`<!DOCTYPE html><html><body><p>Hello</p></body></html>`))
+	if !errors.Is(err, ErrFeedNotFound) {
+		t.Errorf("Expected ErrFeedNotFound, got: %v", err)
+	}
+}
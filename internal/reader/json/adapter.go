@@ -53,6 +53,17 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		feed.Title = feed.SiteURL
 	}
 
+	// Populate the WebSub hub URL if present.
+	for _, hub := range j.jsonFeed.Hubs {
+		hubURL := strings.TrimSpace(hub.URL)
+		if strings.EqualFold(hub.Type, "WebSub") && hubURL != "" {
+			if absoluteHubURL, err := urllib.AbsoluteURL(baseURL, hubURL); err == nil {
+				feed.HubURL = absoluteHubURL
+				break
+			}
+		}
+	}
+
 	// Populate the icon URL if present.
 	for _, iconURL := range []string{j.jsonFeed.FaviconURL, j.jsonFeed.IconURL} {
 		iconURL = strings.TrimSpace(iconURL)
@@ -69,6 +80,14 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		entry.Title = strings.TrimSpace(item.Title)
 		entry.URL = strings.TrimSpace(item.URL)
 
+		// Fallback to the external URL, or to the ID when it is a URL.
+		if entry.URL == "" {
+			entry.URL = strings.TrimSpace(item.ExternalURL)
+		}
+		if entry.URL == "" && urllib.IsAbsoluteURL(strings.TrimSpace(item.ID)) {
+			entry.URL = strings.TrimSpace(item.ID)
+		}
+
 		// Make sure the entry URL is absolute.
 		if entryURL, err := urllib.AbsoluteURL(feed.SiteURL, entry.URL); err == nil {
 			entry.URL = entryURL
@@ -120,7 +139,11 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		// Populate the entry author.
 		itemAuthors := j.jsonFeed.Authors
 		itemAuthors = append(itemAuthors, item.Authors...)
-		itemAuthors = append(itemAuthors, item.Author, j.jsonFeed.Author)
+		for _, author := range []*JSONAuthor{item.Author, j.jsonFeed.Author} {
+			if author != nil {
+				itemAuthors = append(itemAuthors, *author)
+			}
+		}
 
 		var authorNames []string
 		for _, author := range itemAuthors {
@@ -134,20 +157,63 @@ func (j *JSONAdapter) BuildFeed(baseURL string) *model.Feed {
 		authorNames = slices.Compact(authorNames)
 		entry.Author = strings.Join(authorNames, ", ")
 
-		// Populate the entry enclosures.
+		// Populate the entry language, the item language overrides the feed language.
+		entry.Language = strings.TrimSpace(item.Language)
+		if entry.Language == "" {
+			entry.Language = strings.TrimSpace(j.jsonFeed.Language)
+		}
+
+		// Populate the entry enclosures, the item image is used as thumbnail for audio and video attachments.
+		var itemImageURL string
+		var hasThumbnail bool
+		if imageURL := strings.TrimSpace(item.ImageURL); imageURL != "" {
+			itemImageURL, _ = urllib.AbsoluteURL(feed.SiteURL, imageURL)
+		}
+
 		for _, attachment := range item.Attachments {
 			attachmentURL := strings.TrimSpace(attachment.URL)
 			if attachmentURL != "" {
 				if absoluteAttachmentURL, err := urllib.AbsoluteURL(feed.SiteURL, attachmentURL); err == nil {
-					entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
+					enclosure := &model.Enclosure{
 						URL:      absoluteAttachmentURL,
 						MimeType: attachment.MimeType,
 						Size:     attachment.Size,
-					})
+						Duration: attachment.Duration,
+						Title:    strings.TrimSpace(attachment.Title),
+					}
+					if enclosure.IsPlayable() && itemImageURL != "" {
+						enclosure.ThumbnailURL = itemImageURL
+						hasThumbnail = true
+					}
+					entry.Enclosures = append(entry.Enclosures, enclosure)
 				}
 			}
 		}
 
+		// Populate the entry images as enclosures, unless the item image is already the thumbnail of the attachments.
+		itemImageURLs := []string{item.BannerImageURL}
+		if !hasThumbnail {
+			itemImageURLs = []string{item.ImageURL, item.BannerImageURL}
+		}
+
+		for _, imageURL := range itemImageURLs {
+			imageURL = strings.TrimSpace(imageURL)
+			if imageURL == "" {
+				continue
+			}
+
+			if absoluteImageURL, err := urllib.AbsoluteURL(feed.SiteURL, imageURL); err == nil {
+				if slices.ContainsFunc(entry.Enclosures, func(enclosure *model.Enclosure) bool { return enclosure.URL == absoluteImageURL }) {
+					continue
+				}
+
+				entry.Enclosures = append(entry.Enclosures, &model.Enclosure{
+					URL:      absoluteImageURL,
+					MimeType: urllib.ImageMimeType(absoluteImageURL),
+				})
+			}
+		}
+
 		// Populate the entry tags.
 		for _, tag := range item.Tags {
 			tag = strings.TrimSpace(tag)
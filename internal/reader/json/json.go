@@ -3,6 +3,11 @@
 
 package json // import "miniflux.app/v2/internal/reader/json"
 
+import (
+	"encoding/json"
+	"strings"
+)
+
 // JSON Feed specs:
 // https://www.jsonfeed.org/version/1.1/
 // https://www.jsonfeed.org/version/1/
@@ -16,55 +21,55 @@ type JSONFeed struct {
 
 	// HomePageURL  is the URL of the resource that the feed describes.
 	// This resource may or may not actually be a “home” page, but it should be an HTML page.
-	HomePageURL string `json:"home_page_url"`
+	HomePageURL string `json:"home_page_url,omitempty"`
 
 	// FeedURL is the URL of the feed, and serves as the unique identifier for the feed.
-	FeedURL string `json:"feed_url"`
+	FeedURL string `json:"feed_url,omitempty"`
 
 	// Description provides more detail, beyond the title, on what the feed is about.
-	Description string `json:"description"`
+	Description string `json:"description,omitempty"`
 
 	// IconURL is the URL of an image for the feed suitable to be used in a timeline, much the way an avatar might be used.
-	IconURL string `json:"icon"`
+	IconURL string `json:"icon,omitempty"`
 
 	// FaviconURL is the URL of an image for the feed suitable to be used in a source list. It should be square and relatively small.
-	FaviconURL string `json:"favicon"`
+	FaviconURL string `json:"favicon,omitempty"`
 
 	// Authors specifies one or more feed authors. The author object has several members.
-	Authors []JSONAuthor `json:"authors"` // JSON Feed v1.1
+	Authors []JSONAuthor `json:"authors,omitempty"` // JSON Feed v1.1
 
 	// Author specifies the feed author. The author object has several members.
 	// JSON Feed v1 (deprecated)
-	Author JSONAuthor `json:"author"`
+	Author *JSONAuthor `json:"author,omitempty"`
 
 	// Language is the primary language for the feed in the format specified in RFC 5646.
 	// The value is usually a 2-letter language tag from ISO 639-1, optionally followed by a region tag. (Examples: en or en-US.)
-	Language string `json:"language"`
+	Language string `json:"language,omitempty"`
 
 	// Expired is a boolean value that specifies whether or not the feed is finished.
-	Expired bool `json:"expired"`
+	Expired bool `json:"expired,omitempty"`
 
 	// Items is an array, each representing an individual item in the feed.
 	Items []JSONItem `json:"items"`
 
 	// Hubs  describes endpoints that can be used to subscribe to real-time notifications from the publisher of this feed.
-	Hubs []JSONHub `json:"hubs"`
+	Hubs []JSONHub `json:"hubs,omitempty"`
 }
 
 type JSONAuthor struct {
 	// Author's name.
-	Name string `json:"name"`
+	Name string `json:"name,omitempty"`
 
 	// Author's website URL (Blog or micro-blog).
-	WebsiteURL string `json:"url"`
+	WebsiteURL string `json:"url,omitempty"`
 
 	// Author's avatar URL.
-	AvatarURL string `json:"avatar"`
+	AvatarURL string `json:"avatar,omitempty"`
 }
 
 type JSONHub struct {
 	// Type defines the protocol used to talk with the hub: "rssCloud" or "WebSub".
-	Type string `json:"type"`
+	Type string `json:"type,omitempty"`
 
 	// URL is the location of the hub.
 	URL string `json:"url"`
@@ -81,48 +86,76 @@ type JSONItem struct {
 	// ExternalURL is the URL of a page elsewhere.
 	// This is especially useful for linkblogs.
 	// If url links to where you’re talking about a thing, then external_url links to the thing you’re talking about.
-	ExternalURL string `json:"external_url"`
+	ExternalURL string `json:"external_url,omitempty"`
 
 	// Title of the item (optional).
 	// Microblog items in particular may omit titles.
 	Title string `json:"title"`
 
 	// ContentHTML is the HTML body of the item.
-	ContentHTML string `json:"content_html"`
+	ContentHTML string `json:"content_html,omitempty"`
 
 	// ContentText is the text body of the item.
-	ContentText string `json:"content_text"`
+	ContentText string `json:"content_text,omitempty"`
 
 	// Summary is a plain text sentence or two describing the item.
-	Summary string `json:"summary"`
+	Summary string `json:"summary,omitempty"`
 
 	// ImageURL is the URL of the main image for the item.
-	ImageURL string `json:"image"`
+	ImageURL string `json:"image,omitempty"`
 
 	// BannerImageURL is the URL of an image to use as a banner.
-	BannerImageURL string `json:"banner_image"`
+	BannerImageURL string `json:"banner_image,omitempty"`
 
 	// DatePublished is the date the item was published.
-	DatePublished string `json:"date_published"`
+	DatePublished string `json:"date_published,omitempty"`
 
 	// DateModified is the date the item was modified.
-	DateModified string `json:"date_modified"`
+	DateModified string `json:"date_modified,omitempty"`
 
 	// Language is the language of the item.
-	Language string `json:"language"`
+	Language string `json:"language,omitempty"`
 
 	// Authors is an array of JSONAuthor.
-	Authors []JSONAuthor `json:"authors"`
+	Authors []JSONAuthor `json:"authors,omitempty"`
 
 	// Author is a JSONAuthor.
 	// JSON Feed v1 (deprecated)
-	Author JSONAuthor `json:"author"`
+	Author *JSONAuthor `json:"author,omitempty"`
 
 	// Tags is an array of strings.
-	Tags []string `json:"tags"`
+	Tags []string `json:"tags,omitempty"`
 
 	// Attachments is an array of JSONAttachment.
-	Attachments []JSONAttachment `json:"attachments"`
+	Attachments []JSONAttachment `json:"attachments,omitempty"`
+
+	// Extensions contains the custom objects of the item, their keys begin with an underscore.
+	// For example: "_examplecom": {"about": "https://example.com/jsonfeed-extension", "value": 42}.
+	Extensions map[string]json.RawMessage `json:"-"`
+}
+
+// UnmarshalJSON decodes the item and collects its custom extensions.
+func (j *JSONItem) UnmarshalJSON(data []byte) error {
+	type jsonItem JSONItem
+	if err := json.Unmarshal(data, (*jsonItem)(j)); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	for key, value := range fields {
+		if strings.HasPrefix(key, "_") {
+			if j.Extensions == nil {
+				j.Extensions = make(map[string]json.RawMessage)
+			}
+			j.Extensions[key] = value
+		}
+	}
+
+	return nil
 }
 
 type JSONAttachment struct {
@@ -130,14 +163,14 @@ type JSONAttachment struct {
 	URL string `json:"url"`
 
 	// MIME type of the attachment.
-	MimeType string `json:"mime_type"`
+	MimeType string `json:"mime_type,omitempty"`
 
 	// Title of the attachment.
-	Title string `json:"title"`
+	Title string `json:"title,omitempty"`
 
 	// Size of the attachment in bytes.
-	Size int64 `json:"size_in_bytes"`
+	Size int64 `json:"size_in_bytes,omitempty"`
 
 	// Duration of the attachment in seconds.
-	Duration int `json:"duration_in_seconds"`
+	Duration int `json:"duration_in_seconds,omitempty"`
 }
@@ -5,6 +5,7 @@ package json // import "miniflux.app/v2/internal/reader/json"
 
 import (
 	"bytes"
+	"encoding/json"
 	"strings"
 	"testing"
 	"time"
@@ -788,6 +789,105 @@ func TestParseItemTags(t *testing.T) {
 	}
 }
 
+func TestParseVersion11Fields(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "My Example Feed",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"language": "ja",
+		"hubs": [
+			{"type": "rssCloud", "url": "https://cloud.example.org/"},
+			{"type": "WebSub", "url": "/hub"}
+		],
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/1",
+				"content_html": "<p>Content</p>",
+				"image": "/image.png",
+				"banner_image": "https://example.org/image.png"
+			},
+			{
+				"id": "2",
+				"external_url": "https://example.com/article",
+				"language": "en-US",
+				"content_text": "Content"
+			},
+			{
+				"id": "https://example.org/3",
+				"content_text": "Content"
+			}
+		]
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://example.org/hub" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+
+	if feed.Entries[0].Language != "ja" {
+		t.Errorf("Incorrect entry language, got: %s", feed.Entries[0].Language)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 1 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	if feed.Entries[0].Enclosures[0].URL != "https://example.org/image.png" || feed.Entries[0].Enclosures[0].MimeType != "image/png" {
+		t.Errorf("Incorrect enclosure, got: %+v", feed.Entries[0].Enclosures[0])
+	}
+
+	if feed.Entries[1].Language != "en-US" {
+		t.Errorf("Incorrect entry language, got: %s", feed.Entries[1].Language)
+	}
+
+	if feed.Entries[1].URL != "https://example.com/article" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[1].URL)
+	}
+
+	if feed.Entries[2].URL != "https://example.org/3" {
+		t.Errorf("Incorrect entry URL, got: %s", feed.Entries[2].URL)
+	}
+}
+
+func TestParseItemExtensions(t *testing.T) {
+	data := `{
+		"id": "1",
+		"title": "Title",
+		"_examplecom": {"about": "https://example.com/extension", "value": 42},
+		"_blue_shed": {"about": "https://blueshed-podcasts.com/json-feed-extension-docs"}
+	}`
+
+	var item JSONItem
+	if err := json.Unmarshal([]byte(data), &item); err != nil {
+		t.Fatal(err)
+	}
+
+	if item.ID != "1" || item.Title != "Title" {
+		t.Errorf("Incorrect item, got: %+v", item)
+	}
+
+	if len(item.Extensions) != 2 {
+		t.Fatalf("Incorrect number of extensions, got: %d", len(item.Extensions))
+	}
+
+	var extension struct {
+		Value int `json:"value"`
+	}
+	if err := json.Unmarshal(item.Extensions["_examplecom"], &extension); err != nil {
+		t.Fatal(err)
+	}
+
+	if extension.Value != 42 {
+		t.Errorf("Incorrect extension value, got: %d", extension.Value)
+	}
+}
+
 func TestParseFeedFavicon(t *testing.T) {
 	data := `{
 		"version": "https://jsonfeed.org/version/1",
@@ -891,3 +991,48 @@ func TestParseInvalidJSON(t *testing.T) {
 		t.Error("Parse should returns an error")
 	}
 }
+
+func TestParseAttachmentMetadata(t *testing.T) {
+	data := `{
+		"version": "https://jsonfeed.org/version/1.1",
+		"title": "Podcast",
+		"home_page_url": "https://example.org/",
+		"feed_url": "https://example.org/feed.json",
+		"items": [
+			{
+				"id": "1",
+				"url": "https://example.org/episode-1",
+				"image": "/episode-1.jpg",
+				"attachments": [
+					{
+						"url": "https://example.org/episode-1.mp3",
+						"mime_type": "audio/mpeg",
+						"title": "Episode 1",
+						"size_in_bytes": 1024,
+						"duration_in_seconds": 3600
+					}
+				]
+			}
+		]
+	}`
+
+	feed, err := Parse("https://example.org/feed.json", bytes.NewBufferString(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The item image is the thumbnail of the audio attachment, it is not duplicated as an enclosure.
+	if len(feed.Entries[0].Enclosures) != 1 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	enclosure := feed.Entries[0].Enclosures[0]
+	if enclosure.Title != "Episode 1" || enclosure.Duration != 3600 || enclosure.Size != 1024 {
+		t.Errorf("Incorrect enclosure, got: %+v", enclosure)
+	}
+
+	if enclosure.ThumbnailURL != "https://example.org/episode-1.jpg" {
+		t.Errorf("Incorrect enclosure thumbnail, got: %q", enclosure.ThumbnailURL)
+	}
+
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package json // import "miniflux.app/v2/internal/reader/json"
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// Version11 is the URL of the JSON Feed version generated by Miniflux.
+const Version11 = "https://jsonfeed.org/version/1.1"
+
+// NewJSONFeedFromEntries returns a JSON Feed document that contains the given entries.
+func NewJSONFeedFromEntries(title, homePageURL, feedURL string, entries model.Entries) *JSONFeed {
+	jsonFeed := &JSONFeed{
+		Version:     Version11,
+		Title:       title,
+		HomePageURL: homePageURL,
+		FeedURL:     feedURL,
+		Items:       make([]JSONItem, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		jsonFeed.Items = append(jsonFeed.Items, newJSONItemFromEntry(entry))
+	}
+
+	return jsonFeed
+}
+
+func newJSONItemFromEntry(entry *model.Entry) JSONItem {
+	item := JSONItem{
+		ID:          strconv.FormatInt(entry.ID, 10),
+		URL:         entry.URL,
+		Title:       entry.Title,
+		ContentHTML: entry.Content,
+		Tags:        entry.Tags,
+	}
+
+	if !entry.Date.IsZero() {
+		item.DatePublished = entry.Date.Format(time.RFC3339)
+	}
+
+	if !entry.ChangedAt.IsZero() && entry.ChangedAt.After(entry.Date) {
+		item.DateModified = entry.ChangedAt.Format(time.RFC3339)
+	}
+
+	for _, authorName := range strings.Split(entry.Author, ",") {
+		authorName = strings.TrimSpace(authorName)
+		if authorName != "" {
+			item.Authors = append(item.Authors, JSONAuthor{Name: authorName})
+		}
+	}
+
+	for _, enclosure := range entry.Enclosures {
+		item.Attachments = append(item.Attachments, JSONAttachment{
+			URL:      enclosure.URL,
+			MimeType: enclosure.MimeType,
+			Title:    enclosure.Title,
+			Size:     enclosure.Size,
+			Duration: enclosure.Duration,
+		})
+	}
+
+	return item
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package json // import "miniflux.app/v2/internal/reader/json"
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestNewJSONFeedFromEntries(t *testing.T) {
+	entries := model.Entries{
+		{
+			ID:        42,
+			URL:       "https://example.org/article",
+			Title:     "Article",
+			Content:   "<p>Content</p>",
+			Author:    "Author A, Author B",
+			Date:      time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			ChangedAt: time.Date(2024, 3, 2, 10, 0, 0, 0, time.UTC),
+			Tags:      []string{"tag"},
+			Enclosures: model.EnclosureList{
+				{URL: "https://example.org/podcast.mp3", MimeType: "audio/mpeg", Size: 1024},
+			},
+		},
+	}
+
+	jsonFeed := NewJSONFeedFromEntries("Starred", "https://miniflux.example.org/starred", "https://miniflux.example.org/v1/starred/feed.json", entries)
+	if jsonFeed.Version != Version11 {
+		t.Errorf("Incorrect version, got: %s", jsonFeed.Version)
+	}
+
+	if len(jsonFeed.Items) != 1 {
+		t.Fatalf("Incorrect number of items, got: %d", len(jsonFeed.Items))
+	}
+
+	item := jsonFeed.Items[0]
+	if item.ID != "42" || item.URL != "https://example.org/article" || item.ContentHTML != "<p>Content</p>" {
+		t.Errorf("Incorrect item, got: %+v", item)
+	}
+
+	if item.DatePublished != "2024-03-01T10:00:00Z" || item.DateModified != "2024-03-02T10:00:00Z" {
+		t.Errorf("Incorrect item dates, got: %q and %q", item.DatePublished, item.DateModified)
+	}
+
+	if len(item.Authors) != 2 || item.Authors[1].Name != "Author B" {
+		t.Errorf("Incorrect item authors, got: %+v", item.Authors)
+	}
+
+	if len(item.Attachments) != 1 || item.Attachments[0].MimeType != "audio/mpeg" || item.Attachments[0].Size != 1024 {
+		t.Errorf("Incorrect item attachments, got: %+v", item.Attachments)
+	}
+}
+
+func TestSerializedJSONFeedCanBeParsed(t *testing.T) {
+	entries := model.Entries{
+		{
+			ID:      1,
+			URL:     "https://example.org/article",
+			Title:   "Article",
+			Content: "<p>Content</p>",
+			Author:  "Author",
+			Date:    time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	data, err := json.Marshal(NewJSONFeedFromEntries("Category", "https://miniflux.example.org/", "https://miniflux.example.org/feed.json", entries))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	feed, err := Parse("https://miniflux.example.org/feed.json", bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "Category" || len(feed.Entries) != 1 {
+		t.Fatalf("Incorrect feed, got: %+v", feed)
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "Article" || entry.URL != "https://example.org/article" || entry.Author != "Author" || !entry.Date.Equal(entries[0].Date) {
+		t.Errorf("Incorrect entry, got: %+v", entry)
+	}
+}
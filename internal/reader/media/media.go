@@ -4,6 +4,7 @@
 package media // import "miniflux.app/v2/internal/reader/media"
 
 import (
+	"math"
 	"regexp"
 	"strconv"
 	"strings"
@@ -78,10 +79,15 @@ type Group struct {
 
 // Content represents a XML element "media:content".
 type Content struct {
-	URL      string `xml:"url,attr"`
-	Type     string `xml:"type,attr"`
-	FileSize string `xml:"fileSize,attr"`
-	Medium   string `xml:"medium,attr"`
+	URL             string      `xml:"url,attr"`
+	Type            string      `xml:"type,attr"`
+	FileSize        string      `xml:"fileSize,attr"`
+	Medium          string      `xml:"medium,attr"`
+	Duration        string      `xml:"duration,attr"`
+	Width           string      `xml:"width,attr"`
+	Height          string      `xml:"height,attr"`
+	MediaTitle      string      `xml:"http://search.yahoo.com/mrss/ title"`
+	MediaThumbnails []Thumbnail `xml:"http://search.yahoo.com/mrss/ thumbnail"`
 }
 
 // MimeType returns the attachment mime type.
@@ -108,6 +114,34 @@ func (mc *Content) Size() int64 {
 	return size
 }
 
+// DurationInSeconds returns the duration of the media, the attribute may contain a decimal number.
+func (mc *Content) DurationInSeconds() int {
+	duration, _ := strconv.ParseFloat(strings.TrimSpace(mc.Duration), 64)
+	return int(math.Round(duration))
+}
+
+// Dimensions returns the width and the height of the media in pixels.
+func (mc *Content) Dimensions() (int, int) {
+	width, _ := strconv.Atoi(strings.TrimSpace(mc.Width))
+	height, _ := strconv.Atoi(strings.TrimSpace(mc.Height))
+	return width, height
+}
+
+// Title returns the title of the media.
+func (mc *Content) Title() string {
+	return strings.TrimSpace(mc.MediaTitle)
+}
+
+// ThumbnailURL returns the URL of the first thumbnail of the media.
+func (mc *Content) ThumbnailURL() string {
+	for _, thumbnail := range mc.MediaThumbnails {
+		if thumbnailURL := strings.TrimSpace(thumbnail.URL); thumbnailURL != "" {
+			return thumbnailURL
+		}
+	}
+	return ""
+}
+
 // Thumbnail represents a XML element "media:thumbnail".
 type Thumbnail struct {
 	URL string `xml:"url,attr"`
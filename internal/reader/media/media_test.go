@@ -52,6 +52,36 @@ func TestContentSize(t *testing.T) {
 	}
 }
 
+func TestContentMetadata(t *testing.T) {
+	content := &Content{
+		Duration:        "123.6",
+		Width:           "640",
+		Height:          " 360 ",
+		MediaTitle:      " Title ",
+		MediaThumbnails: []Thumbnail{{URL: ""}, {URL: "https://example.org/thumbnail.jpg"}},
+	}
+
+	if duration := content.DurationInSeconds(); duration != 124 {
+		t.Errorf(`Unexpected duration, got %d instead of 124`, duration)
+	}
+
+	if width, height := content.Dimensions(); width != 640 || height != 360 {
+		t.Errorf(`Unexpected dimensions, got %dx%d instead of 640x360`, width, height)
+	}
+
+	if title := content.Title(); title != "Title" {
+		t.Errorf(`Unexpected title, got %q`, title)
+	}
+
+	if thumbnailURL := content.ThumbnailURL(); thumbnailURL != "https://example.org/thumbnail.jpg" {
+		t.Errorf(`Unexpected thumbnail URL, got %q`, thumbnailURL)
+	}
+
+	if duration := (&Content{Duration: "invalid"}).DurationInSeconds(); duration != 0 {
+		t.Errorf(`Unexpected duration, got %d instead of 0`, duration)
+	}
+}
+
 func TestPeerLinkType(t *testing.T) {
 	scenarios := []struct {
 		inputType        string
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagefeed // import "miniflux.app/v2/internal/reader/pagefeed"
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/sanitizer"
+	"miniflux.app/v2/internal/urllib"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html/charset"
+)
+
+// Parse generates a feed from the items of a web page matching the CSS selectors.
+func Parse(baseURL string, data io.Reader, selectors *model.PageSelectors) (*model.Feed, error) {
+	htmlDocumentReader, err := charset.NewReader(data, "text/html")
+	if err != nil {
+		return nil, fmt.Errorf("pagefeed: unable to read document: %w", err)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(htmlDocumentReader)
+	if err != nil {
+		return nil, fmt.Errorf("pagefeed: unable to parse document: %w", err)
+	}
+
+	feed := &model.Feed{
+		FeedURL: baseURL,
+		SiteURL: baseURL,
+		Title:   strings.TrimSpace(doc.Find("head title").First().Text()),
+	}
+
+	// Fallback to the page URL if the title is empty.
+	if feed.Title == "" {
+		feed.Title = feed.SiteURL
+	}
+
+	doc.Find(selectors.Item).Each(func(_ int, item *goquery.Selection) {
+		if entry := buildEntry(baseURL, item, selectors); entry != nil {
+			feed.Entries = append(feed.Entries, entry)
+		}
+	})
+
+	return feed, nil
+}
+
+func buildEntry(baseURL string, item *goquery.Selection, selectors *model.PageSelectors) *model.Entry {
+	entry := model.NewEntry()
+
+	// Without link selector, the item itself or its first link is used.
+	link := findFirst(item, selectors.Link)
+	if selectors.Link == "" && !link.Is("a[href]") {
+		link = item.Find("a[href]").First()
+	}
+	if link.Length() > 0 {
+		if entryURL, err := urllib.AbsoluteURL(baseURL, strings.TrimSpace(link.AttrOr("href", link.Text()))); err == nil {
+			entry.URL = entryURL
+		}
+	}
+
+	// Populate the entry content, all the matching elements are kept like the scraper rules.
+	if selectors.Content != "" {
+		item.Find(selectors.Content).Each(func(_ int, s *goquery.Selection) {
+			if content, err := goquery.OuterHtml(s); err == nil {
+				entry.Content += content
+			}
+		})
+	}
+
+	// Fallback to the link text, and to the content if the item doesn't have a title.
+	if selectors.Title != "" {
+		entry.Title = normalizeText(item.Find(selectors.Title).First().Text())
+	}
+	if entry.Title == "" && link.Length() > 0 {
+		entry.Title = normalizeText(link.Text())
+	}
+	if entry.Title == "" && entry.Content != "" {
+		entry.Title = sanitizer.TruncateHTML(entry.Content, 100)
+	}
+
+	// Items without any title or link are likely matched by mistake.
+	if entry.Title == "" && entry.URL == "" {
+		return nil
+	}
+	if entry.Title == "" {
+		entry.Title = entry.URL
+	}
+	if entry.URL == "" {
+		entry.URL = baseURL
+	}
+
+	// Populate the entry date.
+	if selectors.Date != "" {
+		if element := item.Find(selectors.Date).First(); element.Length() > 0 {
+			value := dateValue(element)
+			if parsedDate, err := date.Parse(value); err != nil {
+				slog.Debug("Unable to parse date from web page",
+					slog.String("date", value),
+					slog.String("url", entry.URL),
+					slog.Any("error", err),
+				)
+			} else {
+				entry.Date = parsedDate
+			}
+		}
+	}
+	if entry.Date.IsZero() {
+		entry.Date = time.Now()
+	}
+
+	// Generate a hash for the entry, the page URL is shared by all items without link.
+	if entry.URL != baseURL {
+		entry.Hash = crypto.Hash(entry.URL)
+	} else {
+		entry.Hash = crypto.Hash(entry.Title + entry.Content)
+	}
+
+	return entry
+}
+
+// findFirst returns the first element matching the selector inside the item, or the item itself without selector.
+func findFirst(item *goquery.Selection, selector string) *goquery.Selection {
+	if selector == "" {
+		return item
+	}
+	return item.Find(selector).First()
+}
+
+// dateValue returns the machine-readable date of the element when available, or its text.
+func dateValue(s *goquery.Selection) string {
+	for _, attribute := range []string{"datetime", "content", "title"} {
+		if value, exists := s.Attr(attribute); exists && strings.TrimSpace(value) != "" {
+			return strings.TrimSpace(value)
+		}
+	}
+	return normalizeText(s.Text())
+}
+
+func normalizeText(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package pagefeed // import "miniflux.app/v2/internal/reader/pagefeed"
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const testPage = `<!DOCTYPE html>
+<html>
+	<head><title>News</title></head>
+	<body>
+		<ul class="news">
+			<li>
+				<h2>First news</h2>
+				<a class="permalink" href="/news/1">Read more</a>
+				<time datetime="2024-03-01T08:00:00Z">March 1st</time>
+				<p class="summary">First summary</p>
+			</li>
+			<li>
+				<h2>Second news</h2>
+				<a class="permalink" href="https://example.com/news/2">Read more</a>
+				<span class="date">2024-03-02</span>
+				<p class="summary">Second summary</p>
+				<p class="summary">More text</p>
+			</li>
+			<li></li>
+		</ul>
+	</body>
+</html>`
+
+func TestParseWithAllSelectors(t *testing.T) {
+	feed, err := Parse("https://example.org/news", strings.NewReader(testPage), &model.PageSelectors{
+		Item:    "ul.news > li",
+		Title:   "h2",
+		Link:    "a.permalink",
+		Date:    "time, .date",
+		Content: ".summary",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Title != "News" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+
+	if feed.FeedURL != "https://example.org/news" || feed.SiteURL != "https://example.org/news" {
+		t.Errorf(`Incorrect feed URLs, got: %q and %q`, feed.FeedURL, feed.SiteURL)
+	}
+
+	// The empty item is ignored.
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	entry := feed.Entries[0]
+	if entry.Title != "First news" {
+		t.Errorf(`Incorrect entry title, got: %q`, entry.Title)
+	}
+
+	if entry.URL != "https://example.org/news/1" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if !entry.Date.Equal(time.Date(2024, time.March, 1, 8, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Incorrect entry date, got: %v`, entry.Date)
+	}
+
+	if entry.Content != `<p class="summary">First summary</p>` {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	entry = feed.Entries[1]
+	if entry.URL != "https://example.com/news/2" {
+		t.Errorf(`Incorrect entry URL, got: %q`, entry.URL)
+	}
+
+	if entry.Date.Year() != 2024 || entry.Date.Month() != time.March || entry.Date.Day() != 2 {
+		t.Errorf(`Incorrect entry date, got: %v`, entry.Date)
+	}
+
+	if entry.Content != `<p class="summary">Second summary</p><p class="summary">More text</p>` {
+		t.Errorf(`Incorrect entry content, got: %q`, entry.Content)
+	}
+
+	if feed.Entries[0].Hash == feed.Entries[1].Hash {
+		t.Error(`Entries should have different hashes`)
+	}
+}
+
+func TestParseWithItemSelectorOnly(t *testing.T) {
+	feed, err := Parse("https://example.org/news", strings.NewReader(testPage), &model.PageSelectors{Item: "ul.news > li"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	// Without title selector, the text of the first link is used.
+	if feed.Entries[0].Title != "Read more" {
+		t.Errorf(`Incorrect entry title, got: %q`, feed.Entries[0].Title)
+	}
+
+	if feed.Entries[0].URL != "https://example.org/news/1" {
+		t.Errorf(`Incorrect entry URL, got: %q`, feed.Entries[0].URL)
+	}
+}
+
+func TestParseWithLinkItems(t *testing.T) {
+	data := `<html><body><nav><a href="/a">Post A</a><a href="/b">Post B</a></nav></body></html>`
+	feed, err := Parse("https://example.org/", strings.NewReader(data), &model.PageSelectors{Item: "nav a"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries) != 2 {
+		t.Fatalf(`Incorrect number of entries, got: %d`, len(feed.Entries))
+	}
+
+	if feed.Entries[1].Title != "Post B" || feed.Entries[1].URL != "https://example.org/b" {
+		t.Errorf(`Incorrect entry, got: %q and %q`, feed.Entries[1].Title, feed.Entries[1].URL)
+	}
+
+	// Fallback to the page URL for the feed title.
+	if feed.Title != "https://example.org/" {
+		t.Errorf(`Incorrect title, got: %q`, feed.Title)
+	}
+}
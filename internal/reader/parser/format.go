@@ -8,7 +8,10 @@ import (
 	"encoding/xml"
 	"io"
 
+	"miniflux.app/v2/internal/reader/hfeed"
 	rxml "miniflux.app/v2/internal/reader/xml"
+
+	"github.com/PuerkitoBio/goquery"
 )
 
 // List of feed formats.
@@ -17,6 +20,7 @@ const (
 	FormatRSS     = "rss"
 	FormatAtom    = "atom"
 	FormatJSON    = "json"
+	FormatHFeed   = "h-feed"
 	FormatUnknown = "unknown"
 )
 
@@ -55,5 +59,11 @@ func DetectFeedFormat(r io.ReadSeeker) (string, string) {
 		}
 	}
 
+	// HTML pages may carry the feed as h-feed microformats.
+	r.Seek(0, io.SeekStart)
+	if doc, err := goquery.NewDocumentFromReader(r); err == nil && hfeed.ContainsFeed(doc) {
+		return FormatHFeed, ""
+	}
+
 	return FormatUnknown, ""
 }
@@ -67,6 +67,31 @@ func TestDetectJSON(t *testing.T) {
 	}
 }
 
+func TestDetectHFeed(t *testing.T) {
+	data := `<!DOCTYPE html>
+	<html>
+		<body>
+			<div class="h-feed">
+				<article class="h-entry"><a class="u-url p-name" href="/post">Post</a></article>
+			</div>
+		</body>
+	</html>`
+	format, _ := DetectFeedFormat(strings.NewReader(data))
+
+	if format != FormatHFeed {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatHFeed)
+	}
+}
+
+func TestDetectSingleHEntryIsUnknown(t *testing.T) {
+	data := `<!DOCTYPE html> <html> <body> <article class="h-entry"><p class="p-name">Post</p></article> </body> </html>`
+	format, _ := DetectFeedFormat(strings.NewReader(data))
+
+	if format != FormatUnknown {
+		t.Errorf(`Wrong format detected: %q instead of %q`, format, FormatUnknown)
+	}
+}
+
 func TestDetectUnknown(t *testing.T) {
 	data := `
 	<!DOCTYPE html> <html> </html>
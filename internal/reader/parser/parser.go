@@ -9,6 +9,7 @@ import (
 
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/atom"
+	"miniflux.app/v2/internal/reader/hfeed"
 	"miniflux.app/v2/internal/reader/json"
 	"miniflux.app/v2/internal/reader/rdf"
 	"miniflux.app/v2/internal/reader/rss"
@@ -33,6 +34,9 @@ func ParseFeed(baseURL string, r io.ReadSeeker) (*model.Feed, error) {
 	case FormatRDF:
 		r.Seek(0, io.SeekStart)
 		return rdf.Parse(baseURL, r)
+	case FormatHFeed:
+		r.Seek(0, io.SeekStart)
+		return hfeed.Parse(baseURL, r)
 	default:
 		return nil, ErrFeedFormatNotDetected
 	}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package podcast // import "miniflux.app/v2/internal/reader/podcast"
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/fetcher"
+)
+
+// Specs: https://github.com/Podcastindex-org/podcast-namespace/blob/main/chapters/jsonChapters.md
+type chaptersDocument struct {
+	Version  string `json:"version"`
+	Chapters []struct {
+		StartTime float64 `json:"startTime"`
+		Title     string  `json:"title"`
+		Image     string  `json:"img"`
+		URL       string  `json:"url"`
+		TOC       *bool   `json:"toc"`
+	} `json:"chapters"`
+}
+
+// ParseChapters returns the chapters of a JSON chapters file, sorted by start time.
+// Chapters hidden from the table of contents are ignored.
+func ParseChapters(baseURL string, r io.Reader) ([]model.PodcastChapter, error) {
+	var document chaptersDocument
+	if err := json.NewDecoder(r).Decode(&document); err != nil {
+		return nil, fmt.Errorf("podcast: unable to parse chapters: %w", err)
+	}
+
+	chapters := make([]model.PodcastChapter, 0, len(document.Chapters))
+	for _, chapter := range document.Chapters {
+		if chapter.TOC != nil && !*chapter.TOC {
+			continue
+		}
+
+		chapters = append(chapters, model.PodcastChapter{
+			StartTime: chapter.StartTime,
+			Title:     strings.TrimSpace(chapter.Title),
+			URL:       absoluteURL(baseURL, chapter.URL),
+			Image:     absoluteURL(baseURL, chapter.Image),
+		})
+	}
+
+	sort.SliceStable(chapters, func(i, j int) bool {
+		return chapters[i].StartTime < chapters[j].StartTime
+	})
+
+	return chapters, nil
+}
+
+// FetchChapters downloads and parses the chapters file of an episode.
+func FetchChapters(requestBuilder *fetcher.RequestBuilder, chaptersURL string) ([]model.PodcastChapter, error) {
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(chaptersURL))
+	defer responseHandler.Close()
+
+	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+		return nil, localizedError.Error()
+	}
+
+	return ParseChapters(responseHandler.EffectiveURL(), responseHandler.Body(config.Opts.HTTPClientMaxBodySize()))
+}
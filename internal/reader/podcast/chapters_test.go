@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package podcast // import "miniflux.app/v2/internal/reader/podcast"
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseChapters(t *testing.T) {
+	data := `{
+		"version": "1.2.0",
+		"chapters": [
+			{"startTime": 120.5, "title": "Second", "url": "/link"},
+			{"startTime": 0, "title": "Intro", "img": "https://example.org/intro.jpg"},
+			{"startTime": 60, "title": "Hidden", "toc": false}
+		]
+	}`
+
+	chapters, err := ParseChapters("https://example.org/chapters.json", strings.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(chapters) != 2 {
+		t.Fatalf("Incorrect number of chapters, got %d", len(chapters))
+	}
+
+	if chapters[0].Title != "Intro" || chapters[0].StartTime != 0 || chapters[0].Image != "https://example.org/intro.jpg" {
+		t.Errorf("Incorrect first chapter, got %+v", chapters[0])
+	}
+
+	if chapters[1].Title != "Second" || chapters[1].StartTime != 120.5 || chapters[1].URL != "https://example.org/link" {
+		t.Errorf("Incorrect second chapter, got %+v", chapters[1])
+	}
+}
+
+func TestParseInvalidChapters(t *testing.T) {
+	if _, err := ParseChapters("https://example.org/chapters.json", strings.NewReader("{")); err == nil {
+		t.Error("Parsing an invalid chapters file should return an error")
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package podcast // import "miniflux.app/v2/internal/reader/podcast"
+
+import (
+	"net/url"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/urllib"
+)
+
+// Specs: https://podcastindex.org/namespace/1.0
+type PodcastChannelElement struct {
+	PodcastFunding []FundingElement `xml:"https://podcastindex.org/namespace/1.0 funding"`
+	PodcastPersons []PersonElement  `xml:"https://podcastindex.org/namespace/1.0 person"`
+}
+
+type PodcastItemElement struct {
+	PodcastTranscripts []TranscriptElement `xml:"https://podcastindex.org/namespace/1.0 transcript"`
+	PodcastChapters    ChaptersElement     `xml:"https://podcastindex.org/namespace/1.0 chapters"`
+	PodcastPersons     []PersonElement     `xml:"https://podcastindex.org/namespace/1.0 person"`
+	PodcastSeason      string              `xml:"https://podcastindex.org/namespace/1.0 season"`
+	PodcastEpisode     EpisodeElement      `xml:"https://podcastindex.org/namespace/1.0 episode"`
+}
+
+type FundingElement struct {
+	URL   string `xml:"url,attr"`
+	Title string `xml:",chardata"`
+}
+
+type PersonElement struct {
+	Name  string `xml:",chardata"`
+	Role  string `xml:"role,attr"`
+	Group string `xml:"group,attr"`
+	Image string `xml:"img,attr"`
+	URL   string `xml:"href,attr"`
+}
+
+type TranscriptElement struct {
+	URL      string `xml:"url,attr"`
+	Type     string `xml:"type,attr"`
+	Language string `xml:"language,attr"`
+	Rel      string `xml:"rel,attr"`
+}
+
+type ChaptersElement struct {
+	URL  string `xml:"url,attr"`
+	Type string `xml:"type,attr"`
+}
+
+type EpisodeElement struct {
+	Number  string `xml:",chardata"`
+	Display string `xml:"display,attr"`
+}
+
+// Metadata returns the podcast information of the item, the persons and funding links of the channel are used as fallback.
+// Relative URLs are resolved against the given base URL, and only HTTP and HTTPS URLs are kept.
+func Metadata(channel *PodcastChannelElement, item *PodcastItemElement, baseURL string) model.PodcastMetadata {
+	metadata := model.PodcastMetadata{
+		Season:  strings.TrimSpace(item.PodcastSeason),
+		Episode: strings.TrimSpace(item.PodcastEpisode.Display),
+	}
+
+	if metadata.Episode == "" {
+		metadata.Episode = strings.TrimSpace(item.PodcastEpisode.Number)
+	}
+
+	if chaptersURL := absoluteURL(baseURL, item.PodcastChapters.URL); chaptersURL != "" {
+		metadata.ChaptersURL = chaptersURL
+		metadata.ChaptersType = strings.TrimSpace(item.PodcastChapters.Type)
+	}
+
+	for _, transcript := range item.PodcastTranscripts {
+		if transcriptURL := absoluteURL(baseURL, transcript.URL); transcriptURL != "" {
+			metadata.Transcripts = append(metadata.Transcripts, model.PodcastTranscript{
+				URL:      transcriptURL,
+				Type:     strings.TrimSpace(transcript.Type),
+				Language: strings.TrimSpace(transcript.Language),
+				Rel:      strings.TrimSpace(transcript.Rel),
+			})
+		}
+	}
+
+	persons := item.PodcastPersons
+	if len(persons) == 0 {
+		persons = channel.PodcastPersons
+	}
+
+	for _, person := range persons {
+		name := strings.TrimSpace(person.Name)
+		if name == "" {
+			continue
+		}
+
+		metadata.Persons = append(metadata.Persons, model.PodcastPerson{
+			Name:  name,
+			Role:  strings.ToLower(strings.TrimSpace(person.Role)),
+			Group: strings.ToLower(strings.TrimSpace(person.Group)),
+			Image: absoluteURL(baseURL, person.Image),
+			URL:   absoluteURL(baseURL, person.URL),
+		})
+	}
+
+	for _, funding := range channel.PodcastFunding {
+		if fundingURL := absoluteURL(baseURL, funding.URL); fundingURL != "" {
+			metadata.Funding = append(metadata.Funding, model.PodcastFunding{
+				URL:   fundingURL,
+				Title: strings.TrimSpace(funding.Title),
+			})
+		}
+	}
+
+	return metadata
+}
+
+// absoluteURL returns the absolute URL of the value, only HTTP and HTTPS URLs are kept.
+func absoluteURL(baseURL, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+
+	absoluteURL, err := urllib.AbsoluteURL(baseURL, value)
+	if err != nil {
+		return ""
+	}
+
+	if parsedURL, err := url.Parse(absoluteURL); err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") {
+		return ""
+	}
+
+	return absoluteURL
+}
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package podcast // import "miniflux.app/v2/internal/reader/podcast"
+
+import "testing"
+
+func TestMetadataKeepsOnlyHTTPURLs(t *testing.T) {
+	channel := &PodcastChannelElement{
+		PodcastFunding: []FundingElement{
+			{URL: "javascript:alert(1)", Title: "Unsafe"},
+			{URL: "/donate", Title: "Donate"},
+		},
+	}
+
+	item := &PodcastItemElement{
+		PodcastChapters: ChaptersElement{URL: "data:application/json,{}"},
+		PodcastTranscripts: []TranscriptElement{
+			{URL: "JavaScript:alert(1)", Type: "text/html"},
+			{URL: "https://example.org/episode.vtt", Type: "text/vtt"},
+		},
+		PodcastPersons: []PersonElement{
+			{Name: "Jane Doe", URL: "javascript:alert(1)", Image: "/jane.jpg"},
+		},
+	}
+
+	metadata := Metadata(channel, item, "https://example.org/feed.xml")
+
+	if metadata.ChaptersURL != "" {
+		t.Errorf(`The chapters URL should be ignored, got %q`, metadata.ChaptersURL)
+	}
+
+	if len(metadata.Transcripts) != 1 || metadata.Transcripts[0].URL != "https://example.org/episode.vtt" {
+		t.Errorf(`Unexpected transcripts, got %+v`, metadata.Transcripts)
+	}
+
+	if len(metadata.Persons) != 1 || metadata.Persons[0].URL != "" || metadata.Persons[0].Image != "https://example.org/jane.jpg" {
+		t.Errorf(`Unexpected persons, got %+v`, metadata.Persons)
+	}
+
+	if len(metadata.Funding) != 1 || metadata.Funding[0].URL != "https://example.org/donate" {
+		t.Errorf(`Unexpected funding, got %+v`, metadata.Funding)
+	}
+}
@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"log/slog"
+	"slices"
+
+	"miniflux.app/v2/internal/integration"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/storage"
+)
+
+// ApplyEntryActions runs the user action rules on the entries created during a feed refresh.
+//
+// Tags are added while processing the feed entries (see applyEntryTags), this function
+// takes care of the actions that must run only once per entry.
+func ApplyEntryActions(store *storage.Storage, user *model.User, entries model.Entries, userIntegrations *model.Integration) {
+	rules := parseEntryActionRules(user)
+	if len(rules) == 0 || len(entries) == 0 {
+		return
+	}
+
+	var readEntryIDs, starredEntryIDs []int64
+
+	for _, entry := range entries {
+		for _, action := range rules.Match(entry) {
+			slog.Debug("Applying action rule to entry",
+				slog.Int64("user_id", user.ID),
+				slog.Int64("entry_id", entry.ID),
+				slog.String("entry_url", entry.URL),
+				slog.String("action", action.String()),
+			)
+
+			switch action.Name {
+			case filter.ActionRead:
+				entry.Status = model.EntryStatusRead
+				readEntryIDs = append(readEntryIDs, entry.ID)
+			case filter.ActionStar:
+				entry.Starred = true
+				starredEntryIDs = append(starredEntryIDs, entry.ID)
+			case filter.ActionIntegration:
+				if userIntegrations == nil {
+					continue
+				}
+
+				if action.Argument == "" {
+					go integration.SendEntry(entry, userIntegrations)
+				} else {
+					go integration.SendEntryToIntegration(entry, userIntegrations, action.Argument)
+				}
+			}
+		}
+	}
+
+	if len(readEntryIDs) > 0 {
+		if err := store.SetEntriesStatus(user.ID, readEntryIDs, model.EntryStatusRead); err != nil {
+			slog.Error("Unable to mark entries as read from action rules",
+				slog.Int64("user_id", user.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+
+	if len(starredEntryIDs) > 0 {
+		if err := store.SetEntriesBookmarkedState(user.ID, starredEntryIDs, true); err != nil {
+			slog.Error("Unable to star entries from action rules",
+				slog.Int64("user_id", user.ID),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
+
+// applyEntryTags adds the tags of the matching "tag:<name>" actions to the entry.
+func applyEntryTags(rules filter.ActionRules, entry *model.Entry) {
+	for _, action := range rules.Match(entry) {
+		if action.Name == filter.ActionTag && !slices.Contains(entry.Tags, action.Argument) {
+			entry.Tags = append(entry.Tags, action.Argument)
+		}
+	}
+}
+
+func parseEntryActionRules(user *model.User) filter.ActionRules {
+	rules, err := filter.ParseActionRules(user.EntryActionRules)
+	if err != nil {
+		slog.Warn("Unable to parse action rules",
+			slog.Int64("user_id", user.ID),
+			slog.Any("error", err),
+		)
+		return nil
+	}
+	return rules
+}
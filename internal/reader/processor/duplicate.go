@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"log/slog"
+	"strings"
+	"time"
+	"unicode"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+const (
+	// duplicateDateWindow is the maximum difference between publication dates of duplicate entries with different URLs.
+	duplicateDateWindow = 72 * time.Hour
+
+	// duplicateTitleMinWords avoids matching short and generic titles like "Weekly links".
+	duplicateTitleMinWords = 4
+
+	// duplicateTitleSimilarity is the minimum similarity between two normalized titles.
+	duplicateTitleSimilarity = 0.85
+)
+
+// MarkDuplicateEntries marks as read the new entries already published by another feed of the user,
+// and returns the entries that are not duplicates.
+//
+// Entries are duplicates when they share the same canonical URL, or when their titles are nearly identical.
+func MarkDuplicateEntries(store *storage.Storage, user *model.User, entries model.Entries) model.Entries {
+	if !user.DeduplicateEntries || len(entries) == 0 {
+		return entries
+	}
+
+	var uniqueEntries model.Entries
+	for _, entry := range entries {
+		original, err := findDuplicateEntry(store, entry)
+		if err != nil {
+			slog.Error("Unable to fetch duplicate candidates",
+				slog.Int64("user_id", user.ID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Any("error", err),
+			)
+			uniqueEntries = append(uniqueEntries, entry)
+			continue
+		}
+
+		if original == nil {
+			uniqueEntries = append(uniqueEntries, entry)
+			continue
+		}
+
+		slog.Debug("Duplicate entry detected",
+			slog.Int64("user_id", user.ID),
+			slog.Int64("entry_id", entry.ID),
+			slog.String("entry_url", entry.URL),
+			slog.Int64("duplicate_of_entry_id", original.ID),
+			slog.Int64("duplicate_of_feed_id", original.FeedID),
+		)
+
+		if err := store.MarkEntryAsDuplicate(user.ID, entry.ID, original.ID); err != nil {
+			slog.Error("Unable to mark entry as duplicate",
+				slog.Int64("user_id", user.ID),
+				slog.Int64("entry_id", entry.ID),
+				slog.Any("error", err),
+			)
+			uniqueEntries = append(uniqueEntries, entry)
+			continue
+		}
+
+		entry.Status = model.EntryStatusRead
+		entry.DuplicateOfID = original.ID
+	}
+
+	return uniqueEntries
+}
+
+// findDuplicateEntry looks for an entry with the same canonical URL first,
+// the entries published around the same date are only compared when there is none.
+func findDuplicateEntry(store *storage.Storage, entry *model.Entry) (*model.Entry, error) {
+	original, err := store.DuplicateEntryByCanonicalURL(entry)
+	if err != nil || original != nil {
+		return original, err
+	}
+
+	candidates, err := store.DuplicateEntryCandidates(entry, duplicateDateWindow)
+	if err != nil {
+		return nil, err
+	}
+
+	return findOriginalEntry(entry, candidates), nil
+}
+
+// findOriginalEntry returns the first candidate matching the entry, candidates are sorted from the oldest.
+func findOriginalEntry(entry *model.Entry, candidates model.Entries) *model.Entry {
+	titleWords := normalizeTitle(entry.Title)
+
+	for _, candidate := range candidates {
+		if entry.CanonicalURL != "" && candidate.CanonicalURL == entry.CanonicalURL {
+			return candidate
+		}
+
+		if len(titleWords) >= duplicateTitleMinWords && titleSimilarity(titleWords, normalizeTitle(candidate.Title)) >= duplicateTitleSimilarity {
+			return candidate
+		}
+	}
+
+	return nil
+}
+
+// normalizeTitle returns the lowercase words of the title, without punctuation.
+func normalizeTitle(title string) []string {
+	return strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// titleSimilarity returns the Jaccard index of the two sets of words, between 0 and 1.
+func titleSimilarity(a, b []string) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	setA := make(map[string]bool, len(a))
+	for _, word := range a {
+		setA[word] = true
+	}
+
+	setB := make(map[string]bool, len(b))
+	for _, word := range b {
+		setB[word] = true
+	}
+
+	intersection := 0
+	for word := range setA {
+		if setB[word] {
+			intersection++
+		}
+	}
+
+	union := len(setA) + len(setB) - intersection
+	return float64(intersection) / float64(union)
+}
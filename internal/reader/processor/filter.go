@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor // import "miniflux.app/v2/internal/reader/processor"
+
+import (
+	"fmt"
+	"log/slog"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// FilterStoredEntries applies the current block and keep rules to the entries already stored for the given feeds.
+//
+// Unread entries are marked as read with the "read" action. The "remove" action also removes read entries.
+// Starred entries are never modified. Nothing is changed when dryRun is true, only the counters are returned.
+func FilterStoredEntries(store *storage.Storage, user *model.User, feeds model.Feeds, action string, dryRun bool) (*model.FilterRulesResult, error) {
+	var statuses []string
+	var newStatus string
+
+	switch action {
+	case model.FilterActionRead:
+		statuses = []string{model.EntryStatusUnread}
+		newStatus = model.EntryStatusRead
+	case model.FilterActionRemove:
+		statuses = []string{model.EntryStatusUnread, model.EntryStatusRead}
+		newStatus = model.EntryStatusRemoved
+	default:
+		return nil, fmt.Errorf("processor: unsupported filter action %q", action)
+	}
+
+	result := &model.FilterRulesResult{Action: action, DryRun: dryRun}
+
+	for _, feed := range feeds {
+		builder := store.NewEntryQueryBuilder(user.ID)
+		builder.WithFeedID(feed.ID)
+		builder.WithStatuses(statuses)
+		builder.WithStarred(false)
+
+		entries, err := builder.GetEntries()
+		if err != nil {
+			return nil, err
+		}
+
+		entryFilter := newEntryFilter(feed, user)
+
+		var entryIDs []int64
+		for _, entry := range entries {
+			if entryFilter.isBlocked(entry) || !entryFilter.isAllowed(entry) {
+				entryIDs = append(entryIDs, entry.ID)
+			}
+		}
+
+		result.CheckedEntries += len(entries)
+		result.MatchedEntries += len(entryIDs)
+
+		slog.Debug("Applied filter rules to stored entries",
+			slog.Int64("user_id", user.ID),
+			slog.Int64("feed_id", feed.ID),
+			slog.String("action", action),
+			slog.Bool("dry_run", dryRun),
+			slog.Int("checked_entries", len(entries)),
+			slog.Int("matched_entries", len(entryIDs)),
+		)
+
+		if dryRun || len(entryIDs) == 0 {
+			continue
+		}
+
+		if err := store.SetEntriesStatus(user.ID, entryIDs, newStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
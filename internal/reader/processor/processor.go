@@ -8,19 +8,22 @@ import (
 	"fmt"
 	"log/slog"
 	"regexp"
-	"slices"
 	"strconv"
 	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/metric"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/fetcher"
+	"miniflux.app/v2/internal/reader/filter"
+	"miniflux.app/v2/internal/reader/readability"
 	"miniflux.app/v2/internal/reader/readingtime"
 	"miniflux.app/v2/internal/reader/rewrite"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/reader/scraper"
 	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/urllib"
 
 	"github.com/PuerkitoBio/goquery"
 )
@@ -35,6 +38,9 @@ var (
 // ProcessFeedEntries downloads original web page for entries and apply filters.
 func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, user *model.User, forceRefresh bool) {
 	var filteredEntries model.Entries
+	actionRules := parseEntryActionRules(user)
+	entryFilter := newEntryFilter(feed, user)
+	siteRules := newSiteRuleResolver(store, feed)
 
 	// Process older entries first
 	for i := len(feed.Entries) - 1; i >= 0; i-- {
@@ -48,11 +54,13 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, user *model.Us
 			slog.Int64("feed_id", feed.ID),
 			slog.String("feed_url", feed.FeedURL),
 		)
-		if isBlockedEntry(feed, entry) || !isAllowedEntry(feed, entry) || !isRecentEntry(entry) {
+		if entryFilter.isBlocked(entry) || !entryFilter.isAllowed(entry) || !isRecentEntry(entry) {
 			continue
 		}
 
 		websiteURL := getUrlFromEntry(feed, entry)
+		entry.CanonicalURL = urllib.CanonicalURL(websiteURL)
+		scraperRules, rewriteRules := siteRules.rules(websiteURL)
 		entryIsNew := store.IsNewEntry(feed.ID, entry.Hash)
 		if feed.Crawler && (entryIsNew || forceRefresh) {
 			slog.Debug("Scraping entry",
@@ -69,19 +77,10 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, user *model.Us
 
 			startTime := time.Now()
 
-			requestBuilder := fetcher.NewRequestBuilder()
-			requestBuilder.WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent())
-			requestBuilder.WithCookie(feed.Cookie)
-			requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-			requestBuilder.WithProxy(config.Opts.HTTPClientProxy())
-			requestBuilder.UseProxy(feed.FetchViaProxy)
-			requestBuilder.IgnoreTLSErrors(feed.AllowSelfSignedCertificates)
-			requestBuilder.DisableHTTP2(feed.DisableHTTP2)
-
-			content, scraperErr := scraper.ScrapeWebsite(
-				requestBuilder,
+			article, scraperErr := scraper.ScrapeWebsite(
+				newScraperRequestBuilder(feed),
 				websiteURL,
-				feed.ScraperRules,
+				scraperRules,
 			)
 
 			if config.Opts.HasMetricsCollector() {
@@ -100,108 +99,145 @@ func ProcessFeedEntries(store *storage.Storage, feed *model.Feed, user *model.Us
 					slog.String("feed_url", feed.FeedURL),
 					slog.Any("error", scraperErr),
 				)
-			} else if content != "" {
+			} else {
 				// We replace the entry content only if the scraper doesn't return any error.
-				entry.Content = content
+				applyScrapedArticle(entry, article)
 			}
 		}
 
-		if isBlockedEntry(feed, entry) || !isAllowedEntry(feed, entry) {
+		if entryFilter.isBlocked(entry) || !entryFilter.isAllowed(entry) {
 			continue
 		}
 
-		rewrite.Rewriter(websiteURL, entry, feed.RewriteRules)
+		rewrite.Rewriter(websiteURL, entry, rewriteRules)
 
 		// The sanitizer should always run at the end of the process to make sure unsafe HTML is filtered.
 		entry.Content = sanitizer.Sanitize(websiteURL, entry.Content)
 
 		updateEntryReadingTime(store, feed, entry, entryIsNew, user)
+		applyEntryTags(actionRules, entry)
 		filteredEntries = append(filteredEntries, entry)
 	}
 
 	feed.Entries = filteredEntries
 }
 
-func isBlockedEntry(feed *model.Feed, entry *model.Entry) bool {
-	if feed.BlocklistRules == "" {
-		return false
+// filterRuleSet is a list of block or keep rules defined at the user, category or feed level.
+type filterRuleSet struct {
+	scope        string
+	rules        filter.Rules
+	matchContent bool
+
+	// invalid is set when the rules cannot be parsed.
+	invalid bool
+}
+
+// entryFilter holds the block and keep rules of the user, the category and the feed, global rules first.
+// The rules are parsed once and applied to all the entries of the feed.
+type entryFilter struct {
+	feed       *model.Feed
+	user       *model.User
+	blockRules []filterRuleSet
+	keepRules  []filterRuleSet
+}
+
+func newEntryFilter(feed *model.Feed, user *model.User) *entryFilter {
+	f := &entryFilter{feed: feed, user: user}
+
+	f.blockRules = append(f.blockRules, f.parseRuleSet("user", user.BlockFilterEntryRules, false))
+	if feed.Category != nil {
+		f.blockRules = append(f.blockRules, f.parseRuleSet("category", feed.Category.BlocklistRules, false))
 	}
+	f.blockRules = append(f.blockRules, f.parseRuleSet("feed", feed.BlocklistRules, feed.ApplyFilterToContent))
+
+	f.keepRules = append(f.keepRules, f.parseRuleSet("user", user.KeepFilterEntryRules, false))
+	if feed.Category != nil {
+		f.keepRules = append(f.keepRules, f.parseRuleSet("category", feed.Category.KeeplistRules, false))
+	}
+	f.keepRules = append(f.keepRules, f.parseRuleSet("feed", feed.KeeplistRules, feed.ApplyFilterToContent))
+
+	return f
+}
+
+func (f *entryFilter) parseRuleSet(scope, input string, matchContent bool) filterRuleSet {
+	ruleSet := filterRuleSet{scope: scope, matchContent: matchContent}
 
-	compiledBlocklist, err := regexp.Compile(feed.BlocklistRules)
+	rules, err := filter.Parse(input)
 	if err != nil {
-		slog.Debug("Failed on regexp compilation",
-			slog.String("pattern", feed.BlocklistRules),
+		slog.Warn("Unable to parse filter rules",
+			slog.Int64("user_id", f.user.ID),
+			slog.Int64("feed_id", f.feed.ID),
+			slog.String("feed_url", f.feed.FeedURL),
+			slog.String("scope", scope),
 			slog.Any("error", err),
 		)
-		return false
+		ruleSet.invalid = true
 	}
 
-	containsBlockedTag := slices.ContainsFunc(entry.Tags, func(tag string) bool {
-		return compiledBlocklist.MatchString(tag)
-	})
+	ruleSet.rules = rules
+	return ruleSet
+}
 
-	if compiledBlocklist.MatchString(entry.URL) || compiledBlocklist.MatchString(entry.Title) || compiledBlocklist.MatchString(entry.Author) || containsBlockedTag || (feed.ApplyFilterToContent && compiledBlocklist.MatchString(entry.Content)) {
-		slog.Debug("Blocking entry based on rule",
-			slog.String("entry_url", entry.URL),
-			slog.Int64("feed_id", feed.ID),
-			slog.String("feed_url", feed.FeedURL),
-			slog.String("rule", feed.BlocklistRules),
-			slog.Bool("apply_filter_to_content", feed.ApplyFilterToContent),
-		)
-		return true
+// isBlocked returns true if any block rule of the user, the category or the feed matches the entry.
+// Invalid block rules are ignored.
+func (f *entryFilter) isBlocked(entry *model.Entry) bool {
+	for _, ruleSet := range f.blockRules {
+		if rule := ruleSet.rules.Match(entry, ruleSet.matchContent); rule != nil {
+			slog.Debug("Blocking entry based on rule",
+				slog.String("entry_url", entry.URL),
+				slog.Int64("feed_id", f.feed.ID),
+				slog.String("feed_url", f.feed.FeedURL),
+				slog.String("scope", ruleSet.scope),
+				slog.String("rule", rule.String()),
+				slog.Bool("apply_filter_to_content", ruleSet.matchContent),
+			)
+			return true
+		}
 	}
 
 	return false
 }
 
-func isAllowedEntry(feed *model.Feed, entry *model.Entry) bool {
-	if feed.KeeplistRules == "" {
-		return true
-	}
+// isAllowed returns true if the entry matches the keep rules of every level that defines some.
+// Invalid keep rules don't allow any entry.
+func (f *entryFilter) isAllowed(entry *model.Entry) bool {
+	for _, ruleSet := range f.keepRules {
+		if ruleSet.invalid {
+			return false
+		}
 
-	compiledKeeplist, err := regexp.Compile(feed.KeeplistRules)
-	if err != nil {
-		slog.Debug("Failed on regexp compilation",
-			slog.String("pattern", feed.KeeplistRules),
-			slog.Any("error", err),
-		)
-		return false
-	}
-	containsAllowedTag := slices.ContainsFunc(entry.Tags, func(tag string) bool {
-		return compiledKeeplist.MatchString(tag)
-	})
+		if len(ruleSet.rules) == 0 {
+			continue
+		}
+
+		rule := ruleSet.rules.Match(entry, ruleSet.matchContent)
+		if rule == nil {
+			return false
+		}
 
-	if compiledKeeplist.MatchString(entry.URL) || compiledKeeplist.MatchString(entry.Title) || compiledKeeplist.MatchString(entry.Author) || containsAllowedTag || (feed.ApplyFilterToContent && compiledKeeplist.MatchString(entry.Content)) {
 		slog.Debug("Allow entry based on rule",
 			slog.String("entry_url", entry.URL),
-			slog.Int64("feed_id", feed.ID),
-			slog.String("feed_url", feed.FeedURL),
-			slog.String("rule", feed.KeeplistRules),
-			slog.Bool("apply_filter_to_content", feed.ApplyFilterToContent),
+			slog.Int64("feed_id", f.feed.ID),
+			slog.String("feed_url", f.feed.FeedURL),
+			slog.String("scope", ruleSet.scope),
+			slog.String("rule", rule.String()),
+			slog.Bool("apply_filter_to_content", ruleSet.matchContent),
 		)
-		return true
 	}
-	return false
+
+	return true
 }
 
 // ProcessEntryWebPage downloads the entry web page and apply rewrite rules.
-func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User) error {
+func ProcessEntryWebPage(store *storage.Storage, feed *model.Feed, entry *model.Entry, user *model.User) error {
 	startTime := time.Now()
 	websiteURL := getUrlFromEntry(feed, entry)
+	scraperRules, rewriteRules := newSiteRuleResolver(store, feed).rules(websiteURL)
 
-	requestBuilder := fetcher.NewRequestBuilder()
-	requestBuilder.WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent())
-	requestBuilder.WithCookie(feed.Cookie)
-	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
-	requestBuilder.WithProxy(config.Opts.HTTPClientProxy())
-	requestBuilder.UseProxy(feed.FetchViaProxy)
-	requestBuilder.IgnoreTLSErrors(feed.AllowSelfSignedCertificates)
-	requestBuilder.DisableHTTP2(feed.DisableHTTP2)
-
-	content, scraperErr := scraper.ScrapeWebsite(
-		requestBuilder,
+	article, scraperErr := scraper.ScrapeWebsite(
+		newScraperRequestBuilder(feed),
 		websiteURL,
-		feed.ScraperRules,
+		scraperRules,
 	)
 
 	if config.Opts.HasMetricsCollector() {
@@ -216,19 +252,79 @@ func ProcessEntryWebPage(feed *model.Feed, entry *model.Entry, user *model.User)
 		return scraperErr
 	}
 
-	if content != "" {
-		entry.Content = content
+	if article.Content != "" {
+		applyScrapedArticle(entry, article)
 		if user.ShowReadingTime {
-			entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, user.DefaultReadingSpeed, user.CJKReadingSpeed)
+			entry.ReadingTime = readingtime.EstimateReadingTimeWithLanguage(entry.Content, entry.Language, user.DefaultReadingSpeed, user.CJKReadingSpeed)
 		}
 	}
 
-	rewrite.Rewriter(websiteURL, entry, entry.Feed.RewriteRules)
+	rewrite.Rewriter(websiteURL, entry, rewriteRules)
 	entry.Content = sanitizer.Sanitize(websiteURL, entry.Content)
 
 	return nil
 }
 
+// PreviewEntryWebPage returns the entry web page processed with the scraper and rewrite rules of the feed,
+// or the site rules of the website when the feed doesn't have any.
+// The entry is not modified and the downloaded pages are cached for a few minutes, to quickly test different rules.
+func PreviewEntryWebPage(store *storage.Storage, feed *model.Feed, entry *model.Entry) (string, error) {
+	websiteURL := getUrlFromEntry(feed, entry)
+	cacheNamespace := scraperCacheNamespace(feed)
+	scraperRules, rewriteRules := newSiteRuleResolver(store, feed).rules(websiteURL)
+
+	article, err := scraper.ScrapeWebsiteWithCache(newScraperRequestBuilder(feed), cacheNamespace, websiteURL, scraperRules)
+	if err != nil {
+		return "", err
+	}
+
+	previewEntry := *entry
+	if article.Content != "" {
+		previewEntry.Content = article.Content
+	}
+
+	rewrite.Rewriter(websiteURL, &previewEntry, rewriteRules)
+	return sanitizer.Sanitize(websiteURL, previewEntry.Content), nil
+}
+
+// applyScrapedArticle replaces the entry content with the scraped article,
+// and fills the author and the publication date when the feed doesn't provide them.
+func applyScrapedArticle(entry *model.Entry, article *readability.Article) {
+	if article.Content == "" {
+		return
+	}
+
+	entry.Content = article.Content
+
+	if entry.Author == "" {
+		entry.Author = article.Byline
+	}
+
+	// Entries without a publication date in the feed are dated when the feed is parsed.
+	if !article.PublishedAt.IsZero() && time.Since(entry.Date) < time.Minute && article.PublishedAt.Before(entry.Date) {
+		entry.Date = article.PublishedAt
+	}
+}
+
+// scraperCacheNamespace identifies the feed and the settings used to download its pages,
+// a page downloaded with a previous cookie, user agent or proxy setting must not be reused.
+func scraperCacheNamespace(feed *model.Feed) string {
+	settings := fmt.Sprintf("%s\n%s\n%t\n%t\n%t", feed.Cookie, feed.UserAgent, feed.FetchViaProxy, feed.AllowSelfSignedCertificates, feed.DisableHTTP2)
+	return fmt.Sprintf("%d:%d:%s", feed.UserID, feed.ID, crypto.Hash(settings))
+}
+
+func newScraperRequestBuilder(feed *model.Feed) *fetcher.RequestBuilder {
+	requestBuilder := fetcher.NewRequestBuilder()
+	requestBuilder.WithUserAgent(feed.UserAgent, config.Opts.HTTPClientUserAgent())
+	requestBuilder.WithCookie(feed.Cookie)
+	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
+	requestBuilder.WithProxy(config.Opts.HTTPClientProxy())
+	requestBuilder.UseProxy(feed.FetchViaProxy)
+	requestBuilder.IgnoreTLSErrors(feed.AllowSelfSignedCertificates)
+	requestBuilder.DisableHTTP2(feed.DisableHTTP2)
+	return requestBuilder
+}
+
 func getUrlFromEntry(feed *model.Feed, entry *model.Entry) string {
 	var url = entry.URL
 	if feed.UrlRewriteRules != "" {
@@ -297,7 +393,7 @@ func updateEntryReadingTime(store *storage.Storage, feed *model.Feed, entry *mod
 	// Handle YT error case and non-YT entries.
 	if entry.ReadingTime == 0 {
 		if user.ShowReadingTime {
-			entry.ReadingTime = readingtime.EstimateReadingTime(entry.Content, user.DefaultReadingSpeed, user.CJKReadingSpeed)
+			entry.ReadingTime = readingtime.EstimateReadingTimeWithLanguage(entry.Content, entry.Language, user.DefaultReadingSpeed, user.CJKReadingSpeed)
 		}
 	}
 }
@@ -4,11 +4,13 @@
 package processor // import "miniflux.app/v2/internal/reader/processor"
 
 import (
+	"strings"
 	"testing"
 	"time"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/readability"
 )
 
 func TestBlockingEntries(t *testing.T) {
@@ -30,10 +32,16 @@ func TestBlockingEntries(t *testing.T) {
 		{&model.Feed{ID: 1, BlocklistRules: "(?i)example"}, &model.Entry{Title: "Something different", Author: "Example"}, true},
 		{&model.Feed{ID: 1, BlocklistRules: "(?i)example"}, &model.Entry{Title: "Something different", Author: "Something different"}, false},
 		{&model.Feed{ID: 1}, &model.Entry{Title: "No rule defined"}, false},
+		{&model.Feed{ID: 1, BlocklistRules: "title~=(?i)sponsored"}, &model.Entry{Title: "Sponsored post"}, true},
+		{&model.Feed{ID: 1, BlocklistRules: "title~=(?i)sponsored"}, &model.Entry{Title: "Regular post", URL: "https://example.org/sponsored"}, false},
+		{&model.Feed{ID: 1, BlocklistRules: "author==Bot\ntag=ads"}, &model.Entry{Title: "Tagged post", Tags: []string{"ads"}}, true},
+		{&model.Feed{ID: 1, BlocklistRules: "author==Bot && tag=ads"}, &model.Entry{Title: "Tagged post", Tags: []string{"ads"}}, false},
+		{&model.Feed{ID: 1, BlocklistRules: "date>30d"}, &model.Entry{Title: "Old post", Date: time.Now().AddDate(0, 0, -60)}, true},
+		{&model.Feed{ID: 1, BlocklistRules: "title~=["}, &model.Entry{Title: "Invalid rule"}, false},
 	}
 
 	for _, tc := range scenarios {
-		result := isBlockedEntry(tc.feed, tc.entry)
+		result := newEntryFilter(tc.feed, &model.User{}).isBlocked(tc.entry)
 		if tc.expected != result {
 			t.Errorf(`Unexpected result, got %v for entry %q`, result, tc.entry.Title)
 		}
@@ -59,16 +67,51 @@ func TestAllowEntries(t *testing.T) {
 		{&model.Feed{ID: 1, KeeplistRules: "(?i)example"}, &model.Entry{Title: "Something more", Tags: []string{"something different", "something else"}}, false},
 		{&model.Feed{ID: 1, KeeplistRules: "(?i)example"}, &model.Entry{Title: "Something different", Author: "Example"}, true},
 		{&model.Feed{ID: 1, KeeplistRules: "(?i)example"}, &model.Entry{Title: "Something different", Author: "Something different"}, false},
+		{&model.Feed{ID: 1, KeeplistRules: "author==Example"}, &model.Entry{Title: "Something different", Author: "Example"}, true},
+		{&model.Feed{ID: 1, KeeplistRules: "author==Example"}, &model.Entry{Title: "Example", Author: "Something different"}, false},
+		{&model.Feed{ID: 1, KeeplistRules: "date<30d"}, &model.Entry{Title: "Recent post", Date: time.Now()}, true},
+		{&model.Feed{ID: 1, KeeplistRules: "title~=["}, &model.Entry{Title: "Invalid rule"}, false},
 	}
 
 	for _, tc := range scenarios {
-		result := isAllowedEntry(tc.feed, tc.entry)
+		result := newEntryFilter(tc.feed, &model.User{}).isAllowed(tc.entry)
 		if tc.expected != result {
 			t.Errorf(`Unexpected result, got %v for entry %q`, result, tc.entry.Title)
 		}
 	}
 }
 
+func TestGlobalFilterRules(t *testing.T) {
+	var scenarios = []struct {
+		feed            *model.Feed
+		user            *model.User
+		entry           *model.Entry
+		expectedBlocked bool
+		expectedAllowed bool
+	}{
+		{&model.Feed{ID: 1}, &model.User{BlockFilterEntryRules: "title~=(?i)sponsored"}, &model.Entry{Title: "Sponsored post"}, true, true},
+		{&model.Feed{ID: 1}, &model.User{BlockFilterEntryRules: "title~=(?i)sponsored"}, &model.Entry{Title: "Regular post"}, false, true},
+		{&model.Feed{ID: 1, Category: &model.Category{BlocklistRules: "tag=ads"}}, &model.User{}, &model.Entry{Title: "Tagged post", Tags: []string{"ads"}}, true, true},
+		{&model.Feed{ID: 1, Category: &model.Category{}, BlocklistRules: "tag=ads"}, &model.User{BlockFilterEntryRules: "author==Bot"}, &model.Entry{Author: "Bot"}, true, true},
+		{&model.Feed{ID: 1, BlocklistRules: "(?i)example", ApplyFilterToContent: true}, &model.User{BlockFilterEntryRules: "(?i)example"}, &model.Entry{Content: "Example"}, true, true},
+		{&model.Feed{ID: 1}, &model.User{BlockFilterEntryRules: "(?i)example"}, &model.Entry{Content: "Example"}, false, true},
+		{&model.Feed{ID: 1}, &model.User{KeepFilterEntryRules: "author==Alice"}, &model.Entry{Author: "Alice"}, false, true},
+		{&model.Feed{ID: 1}, &model.User{KeepFilterEntryRules: "author==Alice"}, &model.Entry{Author: "Bob"}, false, false},
+		{&model.Feed{ID: 1, KeeplistRules: "tag=go"}, &model.User{KeepFilterEntryRules: "author==Alice"}, &model.Entry{Author: "Alice", Tags: []string{"rust"}}, false, false},
+		{&model.Feed{ID: 1, Category: &model.Category{KeeplistRules: "tag=go"}}, &model.User{KeepFilterEntryRules: "author==Alice"}, &model.Entry{Author: "Alice", Tags: []string{"go"}}, false, true},
+	}
+
+	for _, tc := range scenarios {
+		if result := newEntryFilter(tc.feed, tc.user).isBlocked(tc.entry); result != tc.expectedBlocked {
+			t.Errorf(`Unexpected blocking result, got %v for entry %+v`, result, tc.entry)
+		}
+
+		if result := newEntryFilter(tc.feed, tc.user).isAllowed(tc.entry); result != tc.expectedAllowed {
+			t.Errorf(`Unexpected keeping result, got %v for entry %+v`, result, tc.entry)
+		}
+	}
+}
+
 func TestParseISO8601(t *testing.T) {
 	var scenarios = []struct {
 		duration string
@@ -121,3 +164,130 @@ func TestIsRecentEntry(t *testing.T) {
 		}
 	}
 }
+
+func TestApplyEntryTags(t *testing.T) {
+	user := &model.User{EntryActionRules: "title~=(?i)release => star, tag:releases\nauthor==Bot => tag:bots, tag:releases"}
+	rules := parseEntryActionRules(user)
+
+	entry := &model.Entry{Title: "New release", Author: "Bot", Tags: []string{"releases"}}
+	applyEntryTags(rules, entry)
+
+	if len(entry.Tags) != 2 || entry.Tags[0] != "releases" || entry.Tags[1] != "bots" {
+		t.Errorf(`Unexpected tags, got %v`, entry.Tags)
+	}
+
+	entry = &model.Entry{Title: "Something else"}
+	applyEntryTags(rules, entry)
+
+	if len(entry.Tags) != 0 {
+		t.Errorf(`No tag should be added, got %v`, entry.Tags)
+	}
+}
+
+func TestInvalidEntryActionRulesAreIgnored(t *testing.T) {
+	user := &model.User{EntryActionRules: "title==A => delete"}
+	if rules := parseEntryActionRules(user); rules != nil {
+		t.Errorf(`Invalid action rules should be ignored, got %v`, rules)
+	}
+}
+
+func TestApplyScrapedArticle(t *testing.T) {
+	publishedAt := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	article := &readability.Article{Content: "Scraped content", Byline: "Jane Doe", PublishedAt: publishedAt}
+
+	// The feed doesn't provide any author or publication date.
+	entry := &model.Entry{Content: "Summary", Date: time.Now()}
+	applyScrapedArticle(entry, article)
+
+	if entry.Content != "Scraped content" || entry.Author != "Jane Doe" || !entry.Date.Equal(publishedAt) {
+		t.Errorf(`Unexpected entry, got %q, %q and %v`, entry.Content, entry.Author, entry.Date)
+	}
+
+	// The metadata of the feed are kept.
+	entryDate := time.Date(2024, 3, 2, 8, 0, 0, 0, time.UTC)
+	entry = &model.Entry{Author: "John Doe", Date: entryDate}
+	applyScrapedArticle(entry, article)
+
+	if entry.Author != "John Doe" || !entry.Date.Equal(entryDate) {
+		t.Errorf(`The feed metadata should be kept, got %q and %v`, entry.Author, entry.Date)
+	}
+
+	// An empty article doesn't change the entry.
+	entry = &model.Entry{Content: "Summary", Date: time.Now()}
+	applyScrapedArticle(entry, &readability.Article{Byline: "Jane Doe", PublishedAt: publishedAt})
+
+	if entry.Content != "Summary" || entry.Author != "" || entry.Date.Equal(publishedAt) {
+		t.Errorf(`The entry should not be modified, got %q, %q and %v`, entry.Content, entry.Author, entry.Date)
+	}
+}
+
+func TestScraperCacheNamespace(t *testing.T) {
+	feed := &model.Feed{ID: 2, UserID: 1, Cookie: "session=a"}
+	namespace := scraperCacheNamespace(feed)
+
+	if !strings.HasPrefix(namespace, "1:2:") {
+		t.Errorf(`The namespace should identify the user and the feed, got %q`, namespace)
+	}
+
+	if scraperCacheNamespace(&model.Feed{ID: 2, UserID: 1, Cookie: "session=a"}) != namespace {
+		t.Error(`The namespace should be the same for the same settings`)
+	}
+
+	for _, modifiedFeed := range []*model.Feed{
+		{ID: 2, UserID: 1, Cookie: "session=b"},
+		{ID: 2, UserID: 1, Cookie: "session=a", UserAgent: "Custom"},
+		{ID: 2, UserID: 1, Cookie: "session=a", FetchViaProxy: true},
+	} {
+		if scraperCacheNamespace(modifiedFeed) == namespace {
+			t.Errorf(`The namespace should change with the request settings, got %q for %+v`, namespace, modifiedFeed)
+		}
+	}
+}
+
+func TestTitleSimilarity(t *testing.T) {
+	scenarios := []struct {
+		a, b     string
+		expected float64
+	}{
+		{"Go 1.22 is released", "Go 1.22 is released!", 1},
+		{"Go 1.22 is released", "go 1 22 IS released", 1},
+		{"Go 1.22 is released", "Rust 1.75 is released", 3.0 / 7.0},
+		{"", "Something", 0},
+	}
+
+	for _, tc := range scenarios {
+		result := titleSimilarity(normalizeTitle(tc.a), normalizeTitle(tc.b))
+		if result != tc.expected {
+			t.Errorf(`Unexpected similarity between %q and %q, got %v instead of %v`, tc.a, tc.b, result, tc.expected)
+		}
+	}
+}
+
+func TestFindOriginalEntry(t *testing.T) {
+	candidates := model.Entries{
+		{ID: 1, Title: "Weekly links", CanonicalURL: "example.org/weekly"},
+		{ID: 2, Title: "Announcing the new release of the project", CanonicalURL: "example.org/announcement"},
+		{ID: 3, Title: "Something else", CanonicalURL: "example.org/article"},
+	}
+
+	scenarios := []struct {
+		entry    *model.Entry
+		expected int64
+	}{
+		{&model.Entry{Title: "Unrelated", CanonicalURL: "example.org/article"}, 3},
+		{&model.Entry{Title: "Announcing the new release of the project!", CanonicalURL: "aggregator.org/1234"}, 2},
+		{&model.Entry{Title: "Weekly links", CanonicalURL: "aggregator.org/5678"}, 0},
+		{&model.Entry{Title: "Unrelated", CanonicalURL: ""}, 0},
+	}
+
+	for _, tc := range scenarios {
+		var result int64
+		if original := findOriginalEntry(tc.entry, candidates); original != nil {
+			result = original.ID
+		}
+
+		if result != tc.expected {
+			t.Errorf(`Unexpected original entry for %q, got #%d instead of #%d`, tc.entry.Title, result, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package processor
+
+import (
+	"log/slog"
+	"net/url"
+
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+)
+
+// siteRuleResolver finds the scraper and rewrite rules to apply to the web pages of a feed.
+// The rules of the feed come first, then the site rules of the user and the instance-wide site rules.
+// When nothing is found, the scraper and the rewriter fall back to their predefined rules.
+type siteRuleResolver struct {
+	store *storage.Storage
+	feed  *model.Feed
+	cache map[string]model.SiteRules
+}
+
+func newSiteRuleResolver(store *storage.Storage, feed *model.Feed) *siteRuleResolver {
+	return &siteRuleResolver{
+		store: store,
+		feed:  feed,
+		cache: make(map[string]model.SiteRules),
+	}
+}
+
+func (r *siteRuleResolver) rules(websiteURL string) (scraperRules, rewriteRules string) {
+	scraperRules, rewriteRules = r.feed.ScraperRules, r.feed.RewriteRules
+	if scraperRules != "" && rewriteRules != "" {
+		return scraperRules, rewriteRules
+	}
+
+	siteRules := r.siteRules(websiteURL)
+	if scraperRules == "" {
+		scraperRules = siteRules.ScraperRules()
+	}
+
+	if rewriteRules == "" {
+		rewriteRules = siteRules.RewriteRules()
+	}
+
+	return scraperRules, rewriteRules
+}
+
+func (r *siteRuleResolver) siteRules(websiteURL string) model.SiteRules {
+	parsedURL, err := url.Parse(websiteURL)
+	if err != nil || parsedURL.Hostname() == "" {
+		return nil
+	}
+
+	hostname := parsedURL.Hostname()
+	if siteRules, found := r.cache[hostname]; found {
+		return siteRules
+	}
+
+	siteRules, err := r.store.MatchingSiteRules(r.feed.UserID, hostname)
+	if err != nil {
+		slog.Warn("Unable to fetch site rules",
+			slog.Int64("user_id", r.feed.UserID),
+			slog.Int64("feed_id", r.feed.ID),
+			slog.String("hostname", hostname),
+			slog.Any("error", err),
+		)
+	}
+
+	r.cache[hostname] = siteRules
+	return siteRules
+}
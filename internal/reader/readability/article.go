@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readability // import "miniflux.app/v2/internal/reader/readability"
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+
+	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/urllib"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+const (
+	// Elements that never contain the article, removed before scoring.
+	noiseElements = "script,style,noscript,template,nav,aside,footer,form,button,input,select,textarea,svg,[role=navigation],[role=complementary],[role=dialog],[itemtype*=Comment]"
+
+	// Elements kept as they are when they are next to the top candidate.
+	figureElements = "figure,picture,img,video"
+
+	maxBylineLength = 100
+)
+
+var (
+	// Share buttons, newsletter boxes and comment threads are often long enough to be taken for the article.
+	noiseCandidatesRegexp = regexp.MustCompile(`(?i)(^|[\s_-])(share|shares|sharing|social|addthis|sharethis|shariff|newsletter|subscribe|subscription|comments?|commentlist|disqus|respond|related|recommended|trending)([\s_-]|$)`)
+
+	bylinePrefixRegexp = regexp.MustCompile(`(?i)^(by|par|von|por|di|door)\s+`)
+	whitespaceRegexp   = regexp.MustCompile(`\s+`)
+)
+
+// ArticleExtractor scores the page like the legacy extractor, after removing widgets and comment threads.
+// It also finds the title, the byline, the lead image and the publication date of the article.
+type ArticleExtractor struct{}
+
+// Extract implements the Extractor interface.
+func (ArticleExtractor) Extract(pageURL string, page io.Reader) (*Article, error) {
+	document, err := goquery.NewDocumentFromReader(page)
+	if err != nil {
+		return nil, err
+	}
+
+	article := &Article{}
+	extractMetadata(document, pageURL, article)
+
+	removeNodes(document.Find(noiseElements))
+	removeNoiseCandidates(document)
+	transformMisusedDivsIntoParagraphs(document)
+	removeUnlikelyCandidates(document)
+
+	candidates := getCandidates(document)
+	topCandidate := getTopCandidateInDocumentOrder(document, candidates)
+
+	slog.Debug("Readability parsing",
+		slog.Any("candidates", candidates),
+		slog.Any("topCandidate", topCandidate),
+	)
+
+	// The title and the byline are displayed by the application, they should not be repeated at the top of the content.
+	topCandidate.selection.Find("h1").Each(func(i int, s *goquery.Selection) {
+		if article.Title != "" && normalizeText(s.Text()) == normalizeText(article.Title) {
+			removeNodes(s)
+		}
+	})
+	topCandidate.selection.Find(".byline").Each(func(i int, s *goquery.Selection) {
+		if article.Byline != "" && cleanByline(s.Text()) == article.Byline {
+			removeNodes(s)
+		}
+	})
+
+	article.Content = getArticle(topCandidate, candidates, true)
+
+	if article.LeadImageURL != "" && !containsImage(article.Content, pageURL, article.LeadImageURL) {
+		leadImage := fmt.Sprintf(`<figure><img src="%s" alt=""/></figure>`, html.EscapeString(article.LeadImageURL))
+		article.Content = "<div>" + leadImage + strings.TrimPrefix(article.Content, "<div>")
+	}
+
+	return article, nil
+}
+
+// getTopCandidateInDocumentOrder works like getTopCandidate, but the first candidate of the document wins
+// in case of a tie, so the result doesn't depend on the map iteration order.
+func getTopCandidateInDocumentOrder(document *goquery.Document, candidates candidateList) *candidate {
+	var best *candidate
+
+	document.Find("*").Each(func(i int, s *goquery.Selection) {
+		if c, ok := candidates[s.Get(0)]; ok && (best == nil || best.score < c.score) {
+			best = c
+		}
+	})
+
+	if best == nil {
+		best = &candidate{document.Find("body"), 0}
+	}
+
+	return best
+}
+
+func removeNoiseCandidates(document *goquery.Document) {
+	document.Find("*").Each(func(i int, s *goquery.Selection) {
+		if s.Is("html,body,article,main") {
+			return
+		}
+
+		class, _ := s.Attr("class")
+		id, _ := s.Attr("id")
+		if noiseCandidatesRegexp.MatchString(class) || noiseCandidatesRegexp.MatchString(id) {
+			removeNodes(s)
+		}
+	})
+}
+
+func extractMetadata(document *goquery.Document, pageURL string, article *Article) {
+	linkedData := findLinkedDataArticle(document)
+
+	article.Title = firstNonEmpty(
+		metaContent(document, `meta[property="og:title"]`),
+		linkedDataString(linkedData, "headline"),
+		metaContent(document, `meta[name="twitter:title"]`),
+		normalizeText(document.Find("h1").First().Text()),
+		normalizeText(document.Find("title").First().Text()),
+	)
+
+	article.Byline = cleanByline(firstNonEmpty(
+		linkedDataAuthor(linkedData),
+		metaContent(document, `meta[name="author"]`),
+		normalizeText(document.Find(`[rel="author"]`).First().Text()),
+		normalizeText(document.Find(`[itemprop="author"]`).First().Text()),
+		normalizeText(document.Find(".byline").First().Text()),
+	))
+
+	leadImageURL := firstNonEmpty(
+		metaContent(document, `meta[property="og:image"]`),
+		linkedDataImage(linkedData),
+		metaContent(document, `meta[name="twitter:image"]`),
+		metaContent(document, `meta[name="twitter:image:src"]`),
+	)
+	if leadImageURL != "" {
+		if absoluteURL, err := urllib.AbsoluteURL(pageURL, leadImageURL); err == nil {
+			article.LeadImageURL = absoluteURL
+		}
+	}
+
+	publishedDates := []string{
+		linkedDataString(linkedData, "datePublished"),
+		metaContent(document, `meta[property="article:published_time"]`),
+		metaContent(document, `meta[itemprop="datePublished"]`),
+		attributeValue(document, `[itemprop="datePublished"]`, "datetime"),
+		metaContent(document, `meta[name="date"]`),
+		metaContent(document, `meta[name="DC.date.issued"]`),
+		attributeValue(document, "article time[datetime]", "datetime"),
+	}
+	for _, publishedDate := range publishedDates {
+		if publishedDate == "" {
+			continue
+		}
+
+		if t, err := date.Parse(publishedDate); err == nil {
+			article.PublishedAt = t
+			break
+		}
+	}
+}
+
+// findLinkedDataArticle returns the first JSON-LD object having a headline or a publication date.
+func findLinkedDataArticle(document *goquery.Document) map[string]interface{} {
+	var article map[string]interface{}
+
+	document.Find(`script[type="application/ld+json"]`).EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(s.Text()), &data); err != nil {
+			return true
+		}
+
+		article = findLinkedDataObject(data)
+		return article == nil
+	})
+
+	return article
+}
+
+func findLinkedDataObject(data interface{}) map[string]interface{} {
+	switch value := data.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if object := findLinkedDataObject(item); object != nil {
+				return object
+			}
+		}
+	case map[string]interface{}:
+		if _, found := value["headline"]; found {
+			return value
+		}
+
+		if _, found := value["datePublished"]; found {
+			return value
+		}
+
+		if graph, found := value["@graph"]; found {
+			return findLinkedDataObject(graph)
+		}
+	}
+
+	return nil
+}
+
+func linkedDataString(object map[string]interface{}, key string) string {
+	if value, ok := object[key].(string); ok {
+		return strings.TrimSpace(value)
+	}
+	return ""
+}
+
+func linkedDataAuthor(object map[string]interface{}) string {
+	var names []string
+
+	var collect func(value interface{})
+	collect = func(value interface{}) {
+		switch author := value.(type) {
+		case string:
+			names = append(names, strings.TrimSpace(author))
+		case map[string]interface{}:
+			if name, ok := author["name"].(string); ok {
+				names = append(names, strings.TrimSpace(name))
+			}
+		case []interface{}:
+			for _, item := range author {
+				collect(item)
+			}
+		}
+	}
+	collect(object["author"])
+
+	return strings.Join(names, ", ")
+}
+
+func linkedDataImage(object map[string]interface{}) string {
+	switch image := object["image"].(type) {
+	case string:
+		return strings.TrimSpace(image)
+	case map[string]interface{}:
+		if imageURL, ok := image["url"].(string); ok {
+			return strings.TrimSpace(imageURL)
+		}
+	case []interface{}:
+		if len(image) > 0 {
+			return linkedDataImage(map[string]interface{}{"image": image[0]})
+		}
+	}
+	return ""
+}
+
+func metaContent(document *goquery.Document, selector string) string {
+	return attributeValue(document, selector, "content")
+}
+
+func attributeValue(document *goquery.Document, selector, attribute string) string {
+	value, _ := document.Find(selector).First().Attr(attribute)
+	return strings.TrimSpace(value)
+}
+
+func cleanByline(byline string) string {
+	byline = bylinePrefixRegexp.ReplaceAllString(normalizeText(byline), "")
+
+	// Some websites put the URL of the author profile instead of a name.
+	if len(byline) > maxBylineLength || strings.HasPrefix(byline, "http://") || strings.HasPrefix(byline, "https://") {
+		return ""
+	}
+
+	return byline
+}
+
+// containsImage returns true if the content already displays the given image, query strings are ignored.
+func containsImage(content, pageURL, imageURL string) bool {
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return false
+	}
+
+	expected := stripQueryString(imageURL)
+	found := false
+	document.Find("img[src]").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		src, _ := s.Attr("src")
+		if absoluteURL, err := urllib.AbsoluteURL(pageURL, strings.TrimSpace(src)); err == nil && stripQueryString(absoluteURL) == expected {
+			found = true
+		}
+		return !found
+	})
+
+	return found
+}
+
+func stripQueryString(rawURL string) string {
+	if index := strings.IndexAny(rawURL, "?#"); index >= 0 {
+		return rawURL[:index]
+	}
+	return rawURL
+}
+
+func normalizeText(text string) string {
+	return strings.TrimSpace(whitespaceRegexp.ReplaceAllString(text, " "))
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
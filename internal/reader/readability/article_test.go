@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readability // import "miniflux.app/v2/internal/reader/readability"
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var updateGoldenFiles = flag.Bool("update", false, "update the golden files of the readability test corpus")
+
+type goldenArticle struct {
+	Title        string `json:"title"`
+	Byline       string `json:"byline"`
+	LeadImageURL string `json:"lead_image_url"`
+	PublishedAt  string `json:"published_at"`
+	Content      string `json:"content"`
+}
+
+func TestArticleExtractorCorpus(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("The test corpus is empty")
+	}
+
+	for _, file := range files {
+		page, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatalf(`Unable to read file %q: %v`, file, err)
+		}
+
+		pageURL := "https://news.example.org/articles/" + filepath.Base(file)
+		article, err := ArticleExtractor{}.Extract(pageURL, bytes.NewReader(page))
+		if err != nil {
+			t.Fatalf(`Unable to extract article from %q: %v`, file, err)
+		}
+
+		result := goldenArticle{
+			Title:        article.Title,
+			Byline:       article.Byline,
+			LeadImageURL: article.LeadImageURL,
+			Content:      article.Content,
+		}
+		if !article.PublishedAt.IsZero() {
+			result.PublishedAt = article.PublishedAt.UTC().Format(time.RFC3339)
+		}
+
+		var buffer bytes.Buffer
+		encoder := json.NewEncoder(&buffer)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(result); err != nil {
+			t.Fatal(err)
+		}
+
+		goldenFile := strings.TrimSuffix(file, ".html") + ".golden"
+		if *updateGoldenFiles {
+			if err := os.WriteFile(goldenFile, buffer.Bytes(), 0644); err != nil {
+				t.Fatalf(`Unable to write file %q: %v`, goldenFile, err)
+			}
+			continue
+		}
+
+		expected, err := os.ReadFile(goldenFile)
+		if err != nil {
+			t.Fatalf(`Unable to read file %q: %v`, goldenFile, err)
+		}
+
+		if buffer.String() != string(expected) {
+			t.Errorf("Unexpected result for %q, got:\n%s\ninstead of:\n%s", file, buffer.String(), expected)
+		}
+	}
+}
+
+func TestArticleExtractorRemovesCommentsAndShareWidgets(t *testing.T) {
+	page, err := os.ReadFile("testdata/blog-post.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	article, err := ArticleExtractor{}.Extract("https://example.org/post", bytes.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(article.Content, "Great article") {
+		t.Error(`The comments should not be part of the content`)
+	}
+
+	if strings.Contains(article.Content, "Share on Twitter") {
+		t.Error(`The share buttons should not be part of the content`)
+	}
+
+	if !strings.Contains(article.Content, "static site generator") {
+		t.Errorf(`The article text is missing: %s`, article.Content)
+	}
+}
+
+func TestArticleExtractorPrependsLeadImage(t *testing.T) {
+	page := `<html><head><meta property="og:image" content="/cover.jpg"></head><body><div><p>` + strings.Repeat("This is a sentence of the article. ", 10) + `</p></div></body></html>`
+
+	article, err := ArticleExtractor{}.Extract("https://example.org/posts/1", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.LeadImageURL != "https://example.org/cover.jpg" {
+		t.Errorf(`Unexpected lead image URL, got %q`, article.LeadImageURL)
+	}
+
+	if !strings.HasPrefix(article.Content, `<div><figure><img src="https://example.org/cover.jpg" alt=""/></figure>`) {
+		t.Errorf(`The lead image should be at the beginning of the content, got %q`, article.Content)
+	}
+}
+
+func TestArticleExtractorMetadataFallbacks(t *testing.T) {
+	page := `<html>
+		<head>
+			<title>Page title</title>
+			<meta name="twitter:title" content="Twitter title">
+			<meta name="twitter:image" content="https://cdn.example.org/twitter.png">
+		</head>
+		<body>
+			<article>
+				<h1>Heading</h1>
+				<a rel="author" href="/authors/alice">by Alice Martin</a>
+				<time datetime="2022-05-01T10:00:00Z">May 1st</time>
+				<p>` + strings.Repeat("Some words in the article. ", 10) + `</p>
+			</article>
+		</body>
+	</html>`
+
+	article, err := ArticleExtractor{}.Extract("https://example.org/", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "Twitter title" {
+		t.Errorf(`Unexpected title, got %q`, article.Title)
+	}
+
+	if article.Byline != "Alice Martin" {
+		t.Errorf(`Unexpected byline, got %q`, article.Byline)
+	}
+
+	if article.LeadImageURL != "https://cdn.example.org/twitter.png" {
+		t.Errorf(`Unexpected lead image URL, got %q`, article.LeadImageURL)
+	}
+
+	expectedDate := time.Date(2022, time.May, 1, 10, 0, 0, 0, time.UTC)
+	if !article.PublishedAt.Equal(expectedDate) {
+		t.Errorf(`Unexpected publication date, got %v`, article.PublishedAt)
+	}
+}
+
+func TestCleanByline(t *testing.T) {
+	scenarios := map[string]string{
+		"By Jane Doe":                 "Jane Doe",
+		"  Jane\n   Doe ":             "Jane Doe",
+		"https://example.org/jane":    "",
+		strings.Repeat("Jane ", 30):   "",
+		"Bystander Magazine Redactor": "Bystander Magazine Redactor",
+	}
+
+	for input, expected := range scenarios {
+		if result := cleanByline(input); result != expected {
+			t.Errorf(`Unexpected byline for %q, got %q instead of %q`, input, result, expected)
+		}
+	}
+}
+
+func TestLegacyExtractor(t *testing.T) {
+	page := `<html><body><div><p>` + strings.Repeat("This is a sentence of the article. ", 10) + `</p></div></body></html>`
+
+	article, err := LegacyExtractor{}.Extract("https://example.org/", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := ExtractContent(strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Content != expected || article.Title != "" {
+		t.Errorf(`The legacy extractor should only return the content, got %+v`, article)
+	}
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package readability // import "miniflux.app/v2/internal/reader/readability"
+
+import (
+	"io"
+	"time"
+)
+
+// Article is the main content of a web page and its metadata.
+type Article struct {
+	Title        string
+	Byline       string
+	LeadImageURL string
+	PublishedAt  time.Time
+	Content      string
+}
+
+// Extractor finds the main content of a HTML document.
+type Extractor interface {
+	// Extract returns the article of the page, pageURL is used to resolve relative URLs.
+	Extract(pageURL string, page io.Reader) (*Article, error)
+}
+
+// LegacyExtractor is the original candidate scorer, it returns only the content of the page.
+type LegacyExtractor struct{}
+
+// Extract implements the Extractor interface.
+func (LegacyExtractor) Extract(pageURL string, page io.Reader) (*Article, error) {
+	content, err := ExtractContent(page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Article{Content: content}, nil
+}
@@ -90,13 +90,14 @@ func ExtractContent(page io.Reader) (string, error) {
 		slog.Any("topCandidate", topCandidate),
 	)
 
-	output := getArticle(topCandidate, candidates)
+	output := getArticle(topCandidate, candidates, false)
 	return output, nil
 }
 
 // Now that we have the top candidate, look through its siblings for content that might also be related.
 // Things like preambles, content split by ads that we removed, etc.
-func getArticle(topCandidate *candidate, candidates candidateList) string {
+// Sibling figures and images are kept as they are when keepFigures is true.
+func getArticle(topCandidate *candidate, candidates candidateList, keepFigures bool) string {
 	output := bytes.NewBufferString("<div>")
 	siblingScoreThreshold := float32(math.Max(10, float64(topCandidate.score*.2)))
 
@@ -122,6 +123,13 @@ func getArticle(topCandidate *candidate, candidates candidateList) string {
 			}
 		}
 
+		if keepFigures && s.Is(figureElements) {
+			if html, err := goquery.OuterHtml(s); err == nil {
+				output.WriteString(html)
+			}
+			return
+		}
+
 		if append {
 			tag := "div"
 			if s.Is("p") {
@@ -16,8 +16,22 @@ import (
 
 // EstimateReadingTime returns the estimated reading time of an article in minute.
 func EstimateReadingTime(content string, defaultReadingSpeed, cjkReadingSpeed int) int {
+	return EstimateReadingTimeWithLanguage(content, "", defaultReadingSpeed, cjkReadingSpeed)
+}
+
+// EstimateReadingTimeWithLanguage returns the estimated reading time of an article in minute.
+// The language declared by the feed, like "ja" or "zh-TW", is used instead of the detection when available.
+func EstimateReadingTimeWithLanguage(content, language string, defaultReadingSpeed, cjkReadingSpeed int) int {
 	sanitizedContent := sanitizer.StripTags(content)
 
+	if language != "" {
+		if isCJKLanguage(language) {
+			return int(math.Ceil(float64(utf8.RuneCountInString(sanitizedContent)) / float64(cjkReadingSpeed)))
+		}
+		nbOfWords := len(strings.Fields(sanitizedContent))
+		return int(math.Ceil(float64(nbOfWords) / float64(defaultReadingSpeed)))
+	}
+
 	// Litterature on language detection says that around 100 signes is enough, we're safe here.
 	truncationPoint := int(math.Min(float64(len(sanitizedContent)), 250))
 
@@ -37,3 +51,13 @@ func EstimateReadingTime(content string, defaultReadingSpeed, cjkReadingSpeed in
 	nbOfWords := len(strings.Fields(sanitizedContent))
 	return int(math.Ceil(float64(nbOfWords) / float64(defaultReadingSpeed)))
 }
+
+func isCJKLanguage(language string) bool {
+	primaryTag, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(language)), "-")
+	switch primaryTag {
+	case "ja", "zh", "ko":
+		return true
+	default:
+		return false
+	}
+}
@@ -79,6 +79,25 @@ func TestEstimateReadingTime(t *testing.T) {
 	}
 }
 
+func TestEstimateReadingTimeWithLanguage(t *testing.T) {
+	scenarios := []struct {
+		language string
+		want     int
+	}{
+		{"", 5},
+		{"ko", 5},
+		{"KO-kr", 5},
+		{"en-US", 3},
+	}
+
+	for _, tc := range scenarios {
+		got := EstimateReadingTimeWithLanguage(samples["korean"], tc.language, 200, 500)
+		if got != tc.want {
+			t.Errorf(`Wrong reading time for language %q, got %d instead of %d`, tc.language, got, tc.want)
+		}
+	}
+}
+
 func BenchmarkEstimateReadingTime(b *testing.B) {
 	for range b.N {
 		for _, sample := range samples {
@@ -10,9 +10,12 @@ import (
 	"log/slog"
 	"net/url"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/reader/date"
 
 	nethtml "golang.org/x/net/html"
 
@@ -456,3 +459,142 @@ func removeTables(entryContent string) string {
 	output, _ := doc.Find("body").First().Html()
 	return output
 }
+
+// transformContent parses the entry content, applies the transformation and returns the updated content.
+func transformContent(entryContent string, transform func(document *goquery.Document)) string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return entryContent
+	}
+
+	transform(doc)
+
+	output, _ := doc.Find("body").First().Html()
+	return output
+}
+
+func setAttribute(entryContent, selector, attribute, value string) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).SetAttr(attribute, value)
+	})
+}
+
+func renameAttribute(entryContent, selector, oldName, newName string) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+			if value, found := s.Attr(oldName); found {
+				s.RemoveAttr(oldName)
+				s.SetAttr(newName, value)
+			}
+		})
+	})
+}
+
+func removeAttribute(entryContent, selector, attribute string) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).RemoveAttr(attribute)
+	})
+}
+
+func replaceAttribute(entryContent, selector, attribute, searchTerm, replaceTerm string) string {
+	re, err := regexp.Compile(searchTerm)
+	if err != nil {
+		return entryContent
+	}
+
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+			if value, found := s.Attr(attribute); found {
+				s.SetAttr(attribute, re.ReplaceAllString(value, replaceTerm))
+			}
+		})
+	})
+}
+
+func wrapElements(entryContent, selector, wrapper string) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).WrapHtml(wrapper)
+	})
+}
+
+func unwrapElements(entryContent, selector string) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+			s.ReplaceWithSelection(s.Contents())
+		})
+	})
+}
+
+func moveElements(entryContent, selector string, toTop bool) string {
+	return transformContent(entryContent, func(doc *goquery.Document) {
+		elements := doc.Find(selector)
+		if toTop {
+			doc.Find("body").PrependSelection(elements)
+		} else {
+			doc.Find("body").AppendSelection(elements)
+		}
+	})
+}
+
+func hasElement(entryContent, selector string) bool {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return false
+	}
+
+	return doc.Find(selector).Length() > 0
+}
+
+func findTexts(entryContent, selector string) []string {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return nil
+	}
+
+	var texts []string
+	doc.Find(selector).Each(func(i int, s *goquery.Selection) {
+		text := strings.Join(strings.Fields(s.Text()), " ")
+		if text != "" && !slices.Contains(texts, text) {
+			texts = append(texts, text)
+		}
+	})
+
+	return texts
+}
+
+func findText(entryContent, selector string) string {
+	if texts := findTexts(entryContent, selector); len(texts) > 0 {
+		return texts[0]
+	}
+	return ""
+}
+
+// findDate returns the date of the first element matching the selector,
+// using the datetime or content attribute before the text of the element.
+func findDate(entryContent, selector string) (time.Time, bool) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(entryContent))
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	element := doc.Find(selector).First()
+	value, found := element.Attr("datetime")
+	if !found {
+		value, found = element.Attr("content")
+	}
+	if !found {
+		value = element.Text()
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+
+	parsedDate, err := date.Parse(value)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return parsedDate, true
+}
@@ -4,6 +4,7 @@
 package rewrite // import "miniflux.app/v2/internal/reader/rewrite"
 
 import (
+	"fmt"
 	"log/slog"
 	"strconv"
 	"strings"
@@ -11,93 +12,73 @@ import (
 
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/urllib"
-
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
+// Rules are written as function calls with quoted arguments separated by a pipe, for example:
+//
+//	add_image_title
+//	remove(".ads, .share")
+//	if_url("/podcasts/") if_selector("audio") wrap("audio"|"<figure></figure>")
+//
+// Rules can be separated by commas or new lines. Conditions apply to the next rule only,
+// and all of them must match for the rule to be applied.
 type rule struct {
+	name       string
+	args       []string
+	conditions []condition
+	line       int
+}
+
+type condition struct {
 	name string
 	args []string
+	line int
+}
+
+// ValidationError describes an invalid rewrite rule.
+type ValidationError struct {
+	Line    int
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
 }
 
 func (rule rule) applyRule(entryURL string, entry *model.Entry) {
-	switch rule.name {
-	case "add_image_title":
-		entry.Content = addImageTitle(entryURL, entry.Content)
-	case "add_mailto_subject":
-		entry.Content = addMailtoSubject(entryURL, entry.Content)
-	case "add_dynamic_image":
-		entry.Content = addDynamicImage(entryURL, entry.Content)
-	case "add_dynamic_iframe":
-		entry.Content = addDynamicIframe(entryURL, entry.Content)
-	case "add_youtube_video":
-		entry.Content = addYoutubeVideo(entryURL, entry.Content)
-	case "add_invidious_video":
-		entry.Content = addInvidiousVideo(entryURL, entry.Content)
-	case "add_youtube_video_using_invidious_player":
-		entry.Content = addYoutubeVideoUsingInvidiousPlayer(entryURL, entry.Content)
-	case "add_youtube_video_from_id":
-		entry.Content = addYoutubeVideoFromId(entry.Content)
-	case "add_pdf_download_link":
-		entry.Content = addPDFLink(entryURL, entry.Content)
-	case "nl2br":
-		entry.Content = strings.ReplaceAll(entry.Content, "\n", "<br>")
-	case "convert_text_link", "convert_text_links":
-		entry.Content = replaceTextLinks(entry.Content)
-	case "fix_medium_images":
-		entry.Content = fixMediumImages(entryURL, entry.Content)
-	case "use_noscript_figure_images":
-		entry.Content = useNoScriptImages(entryURL, entry.Content)
-	case "replace":
-		// Format: replace("search-term"|"replace-term")
-		if len(rule.args) >= 2 {
-			entry.Content = replaceCustom(entry.Content, rule.args[0], rule.args[1])
-		} else {
-			slog.Warn("Cannot find search and replace terms for replace rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "replace_title":
-		// Format: replace_title("search-term"|"replace-term")
-		if len(rule.args) >= 2 {
-			entry.Title = replaceCustom(entry.Title, rule.args[0], rule.args[1])
-		} else {
-			slog.Warn("Cannot find search and replace terms for replace_title rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "remove":
-		// Format: remove("#selector > .element, .another")
-		if len(rule.args) >= 1 {
-			entry.Content = removeCustom(entry.Content, rule.args[0])
-		} else {
-			slog.Warn("Cannot find selector for remove rule",
-				slog.Any("rule", rule),
-				slog.String("entry_url", entryURL),
-			)
-		}
-	case "add_castopod_episode":
-		entry.Content = addCastopodEpisode(entryURL, entry.Content)
-	case "base64_decode":
-		selector := "body"
-		if len(rule.args) >= 1 {
-			selector = rule.args[0]
+	definition, found := ruleDefinitions[rule.name]
+	if !found {
+		slog.Debug("Ignoring unknown rewrite rule",
+			slog.String("rule", rule.name),
+			slog.String("entry_url", entryURL),
+		)
+		return
+	}
+
+	if len(rule.args) < definition.minArgs() {
+		slog.Warn("Missing arguments for rewrite rule",
+			slog.Any("rule", rule),
+			slog.String("entry_url", entryURL),
+		)
+		return
+	}
+
+	for _, condition := range rule.conditions {
+		if !condition.matches(entryURL, entry) {
+			return
 		}
-		entry.Content = applyFuncOnTextContent(entry.Content, selector, decodeBase64Content)
-	case "add_hn_links_using_hack":
-		entry.Content = addHackerNewsLinksUsing(entry.Content, "hack")
-	case "add_hn_links_using_opener":
-		entry.Content = addHackerNewsLinksUsing(entry.Content, "opener")
-	case "parse_markdown":
-		entry.Content = parseMarkdown(entry.Content)
-	case "remove_tables":
-		entry.Content = removeTables(entry.Content)
-	case "remove_clickbait":
-		entry.Title = cases.Title(language.English).String(strings.ToLower(entry.Title))
 	}
+
+	definition.apply(entryURL, entry, rule.args)
+}
+
+func (condition condition) matches(entryURL string, entry *model.Entry) bool {
+	definition, found := conditionDefinitions[condition.name]
+	if !found || len(condition.args) < definition.minArgs() {
+		return false
+	}
+
+	return definition.match(entryURL, entry, condition.args)
 }
 
 // Rewriter modify item contents with a set of rewriting rules.
@@ -120,21 +101,85 @@ func Rewriter(entryURL string, entry *model.Entry, customRewriteRules string) {
 	}
 }
 
-func parseRules(rulesText string) (rules []rule) {
+// ValidateRules checks the syntax, the names and the arguments of the rewrite rules.
+func ValidateRules(rulesText string) *ValidationError {
+	rules, err := parseRulesWithError(rulesText)
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		for _, condition := range rule.conditions {
+			if err := conditionDefinitions[condition.name].validate(condition.name, condition.args); err != nil {
+				return &ValidationError{Line: condition.line, Message: err.Error()}
+			}
+		}
+
+		definition, found := ruleDefinitions[rule.name]
+		if !found {
+			return &ValidationError{Line: rule.line, Message: fmt.Sprintf("unknown rule %q", rule.name)}
+		}
+
+		if err := definition.validate(rule.name, rule.args); err != nil {
+			return &ValidationError{Line: rule.line, Message: err.Error()}
+		}
+	}
+
+	return nil
+}
+
+func parseRules(rulesText string) []rule {
+	rules, _ := parseRulesWithError(rulesText)
+	return rules
+}
+
+// parseRulesWithError returns the parsed rules and the first syntax error.
+func parseRulesWithError(rulesText string) (rules []rule, err *ValidationError) {
+	var conditions []condition
+	var args *[]string
+
 	scan := scanner.Scanner{Mode: scanner.ScanIdents | scanner.ScanStrings}
 	scan.Init(strings.NewReader(rulesText))
+	scan.Error = func(s *scanner.Scanner, msg string) {
+		if err == nil {
+			err = &ValidationError{Line: s.Pos().Line, Message: msg}
+		}
+	}
 
 	for {
 		switch scan.Scan() {
 		case scanner.Ident:
-			rules = append(rules, rule{name: scan.TokenText()})
+			name := scan.TokenText()
+			if _, found := conditionDefinitions[name]; found {
+				conditions = append(conditions, condition{name: name, line: scan.Position.Line})
+				args = &conditions[len(conditions)-1].args
+			} else {
+				rules = append(rules, rule{name: name, conditions: conditions, line: scan.Position.Line})
+				args = &rules[len(rules)-1].args
+				conditions = nil
+			}
 		case scanner.String:
-			if l := len(rules) - 1; l >= 0 {
-				text, _ := strconv.Unquote(scan.TokenText())
-				rules[l].args = append(rules[l].args, text)
+			text, unquoteErr := strconv.Unquote(scan.TokenText())
+			if unquoteErr != nil {
+				if err == nil {
+					err = &ValidationError{Line: scan.Position.Line, Message: fmt.Sprintf("invalid string %s", scan.TokenText())}
+				}
+				continue
 			}
+
+			if args == nil {
+				if err == nil {
+					err = &ValidationError{Line: scan.Position.Line, Message: fmt.Sprintf("argument %q is not attached to a rule", text)}
+				}
+				continue
+			}
+
+			*args = append(*args, text)
 		case scanner.EOF:
-			return
+			if l := len(conditions) - 1; l >= 0 && err == nil {
+				err = &ValidationError{Line: conditions[l].line, Message: fmt.Sprintf("condition %q is not followed by a rule", conditions[l].name)}
+			}
+			return rules, err
 		}
 	}
 }
@@ -16,9 +16,9 @@ import (
 func TestParseRules(t *testing.T) {
 	rulesText := `add_dynamic_image,replace("article/(.*).svg"|"article/$1.png"),remove(".spam, .ads:not(.keep)")`
 	expected := []rule{
-		{name: "add_dynamic_image"},
-		{name: "replace", args: []string{"article/(.*).svg", "article/$1.png"}},
-		{name: "remove", args: []string{".spam, .ads:not(.keep)"}},
+		{name: "add_dynamic_image", line: 1},
+		{name: "replace", args: []string{"article/(.*).svg", "article/$1.png"}, line: 1},
+		{name: "remove", args: []string{".spam, .ads:not(.keep)"}, line: 1},
 	}
 
 	actual := parseRules(rulesText)
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package rewrite // import "miniflux.app/v2/internal/reader/rewrite"
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"miniflux.app/v2/internal/model"
+
+	"github.com/andybalholm/cascadia"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+type argumentKind int
+
+const (
+	textArgument argumentKind = iota
+	regexArgument
+	selectorArgument
+)
+
+// arguments describes the arguments expected by a rule or a condition.
+// The last arguments can be optional.
+type arguments struct {
+	kinds    []argumentKind
+	optional int
+}
+
+func (a arguments) minArgs() int {
+	return len(a.kinds) - a.optional
+}
+
+func (a arguments) validate(name string, args []string) error {
+	if len(args) < a.minArgs() || len(args) > len(a.kinds) {
+		expected := fmt.Sprintf("%d", len(a.kinds))
+		if a.optional > 0 {
+			expected = fmt.Sprintf("%d to %d", a.minArgs(), len(a.kinds))
+		}
+		return fmt.Errorf("%q expects %s argument(s), got %d", name, expected, len(args))
+	}
+
+	for i, arg := range args {
+		switch a.kinds[i] {
+		case regexArgument:
+			if _, err := regexp.Compile(arg); err != nil {
+				return fmt.Errorf("%q has an invalid regular expression %q", name, arg)
+			}
+		case selectorArgument:
+			if _, err := cascadia.Compile(arg); err != nil {
+				return fmt.Errorf("%q has an invalid CSS selector %q", name, arg)
+			}
+		}
+	}
+
+	return nil
+}
+
+type ruleDefinition struct {
+	arguments
+	apply func(entryURL string, entry *model.Entry, args []string)
+}
+
+type conditionDefinition struct {
+	arguments
+	match func(entryURL string, entry *model.Entry, args []string) bool
+}
+
+var (
+	regexArgs              = arguments{kinds: []argumentKind{regexArgument}}
+	selectorArgs           = arguments{kinds: []argumentKind{selectorArgument}}
+	optionalSelectorArgs   = arguments{kinds: []argumentKind{selectorArgument}, optional: 1}
+	regexAndTextArgs       = arguments{kinds: []argumentKind{regexArgument, textArgument}}
+	selectorAndTextArgs    = arguments{kinds: []argumentKind{selectorArgument, textArgument}}
+	selectorAndTwoTextArgs = arguments{kinds: []argumentKind{selectorArgument, textArgument, textArgument}}
+)
+
+// ruleDefinitions is the list of available rewrite rules.
+var ruleDefinitions = map[string]ruleDefinition{
+	"add_image_title":                          contentRule(addImageTitle),
+	"add_mailto_subject":                       contentRule(addMailtoSubject),
+	"add_dynamic_image":                        contentRule(addDynamicImage),
+	"add_dynamic_iframe":                       contentRule(addDynamicIframe),
+	"add_youtube_video":                        contentRule(addYoutubeVideo),
+	"add_invidious_video":                      contentRule(addInvidiousVideo),
+	"add_youtube_video_using_invidious_player": contentRule(addYoutubeVideoUsingInvidiousPlayer),
+	"add_youtube_video_from_id":                contentRule(ignoreURL(addYoutubeVideoFromId)),
+	"add_pdf_download_link":                    contentRule(addPDFLink),
+	"nl2br":                                    contentRule(ignoreURL(func(entryContent string) string { return strings.ReplaceAll(entryContent, "\n", "<br>") })),
+	"convert_text_link":                        contentRule(ignoreURL(replaceTextLinks)),
+	"convert_text_links":                       contentRule(ignoreURL(replaceTextLinks)),
+	"fix_medium_images":                        contentRule(fixMediumImages),
+	"use_noscript_figure_images":               contentRule(useNoScriptImages),
+	"add_castopod_episode":                     contentRule(addCastopodEpisode),
+	"add_hn_links_using_hack":                  contentRule(ignoreURL(func(entryContent string) string { return addHackerNewsLinksUsing(entryContent, "hack") })),
+	"add_hn_links_using_opener":                contentRule(ignoreURL(func(entryContent string) string { return addHackerNewsLinksUsing(entryContent, "opener") })),
+	"parse_markdown":                           contentRule(ignoreURL(parseMarkdown)),
+	"remove_tables":                            contentRule(ignoreURL(removeTables)),
+
+	// Format: replace("search-term"|"replace-term")
+	"replace": {arguments: regexAndTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = replaceCustom(entry.Content, args[0], args[1])
+	}},
+
+	// Format: replace_title("search-term"|"replace-term")
+	"replace_title": {arguments: regexAndTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Title = replaceCustom(entry.Title, args[0], args[1])
+	}},
+
+	"remove_clickbait": {apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Title = cases.Title(language.English).String(strings.ToLower(entry.Title))
+	}},
+
+	// Format: remove("#selector > .element, .another")
+	"remove": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = removeCustom(entry.Content, args[0])
+	}},
+
+	// Format: base64_decode or base64_decode("selector")
+	"base64_decode": {arguments: optionalSelectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		selector := "body"
+		if len(args) >= 1 {
+			selector = args[0]
+		}
+		entry.Content = applyFuncOnTextContent(entry.Content, selector, decodeBase64Content)
+	}},
+
+	// Format: set_attribute("selector"|"attribute"|"value")
+	"set_attribute": {arguments: selectorAndTwoTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = setAttribute(entry.Content, args[0], args[1], args[2])
+	}},
+
+	// Format: rename_attribute("selector"|"old-name"|"new-name")
+	"rename_attribute": {arguments: selectorAndTwoTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = renameAttribute(entry.Content, args[0], args[1], args[2])
+	}},
+
+	// Format: remove_attribute("selector"|"attribute")
+	"remove_attribute": {arguments: selectorAndTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = removeAttribute(entry.Content, args[0], args[1])
+	}},
+
+	// Format: replace_attribute("selector"|"attribute"|"search-term"|"replace-term")
+	"replace_attribute": {
+		arguments: arguments{kinds: []argumentKind{selectorArgument, textArgument, regexArgument, textArgument}},
+		apply: func(entryURL string, entry *model.Entry, args []string) {
+			entry.Content = replaceAttribute(entry.Content, args[0], args[1], args[2], args[3])
+		},
+	},
+
+	// Format: wrap("selector"|"<figure></figure>")
+	"wrap": {arguments: selectorAndTextArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = wrapElements(entry.Content, args[0], args[1])
+	}},
+
+	// Format: unwrap("selector")
+	"unwrap": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = unwrapElements(entry.Content, args[0])
+	}},
+
+	// Format: move_to_top("selector")
+	"move_to_top": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = moveElements(entry.Content, args[0], true)
+	}},
+
+	// Format: move_to_bottom("selector")
+	"move_to_bottom": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = moveElements(entry.Content, args[0], false)
+	}},
+
+	// Format: set_author("selector")
+	"set_author": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		if author := findText(entry.Content, args[0]); author != "" {
+			entry.Author = author
+		}
+	}},
+
+	// Format: set_date("selector")
+	"set_date": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		if date, found := findDate(entry.Content, args[0]); found {
+			entry.Date = date
+		}
+	}},
+
+	// Format: set_tags("selector")
+	"set_tags": {arguments: selectorArgs, apply: func(entryURL string, entry *model.Entry, args []string) {
+		if tags := findTexts(entry.Content, args[0]); len(tags) > 0 {
+			entry.Tags = tags
+		}
+	}},
+}
+
+// conditionDefinitions is the list of conditions that can be added before a rule.
+var conditionDefinitions = map[string]conditionDefinition{
+	// Format: if_url("regex")
+	"if_url": {arguments: regexArgs, match: func(entryURL string, entry *model.Entry, args []string) bool {
+		matched, err := regexp.MatchString(args[0], entryURL)
+		return err == nil && matched
+	}},
+
+	// Format: if_selector("selector")
+	"if_selector": {arguments: selectorArgs, match: func(entryURL string, entry *model.Entry, args []string) bool {
+		return hasElement(entry.Content, args[0])
+	}},
+}
+
+// contentRule returns the definition of a rule without arguments that transforms the entry content.
+func contentRule(transform func(entryURL, entryContent string) string) ruleDefinition {
+	return ruleDefinition{apply: func(entryURL string, entry *model.Entry, args []string) {
+		entry.Content = transform(entryURL, entry.Content)
+	}}
+}
+
+func ignoreURL(transform func(entryContent string) string) func(entryURL, entryContent string) string {
+	return func(entryURL, entryContent string) string {
+		return transform(entryContent)
+	}
+}
@@ -0,0 +1,159 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package rewrite // import "miniflux.app/v2/internal/reader/rewrite"
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestParseRulesWithConditions(t *testing.T) {
+	rulesText := `add_image_title
+if_url("/news/") if_selector("figure") remove(".ads")
+unwrap("span")`
+
+	expected := []rule{
+		{name: "add_image_title", line: 1},
+		{
+			name: "remove",
+			args: []string{".ads"},
+			conditions: []condition{
+				{name: "if_url", args: []string{"/news/"}, line: 2},
+				{name: "if_selector", args: []string{"figure"}, line: 2},
+			},
+			line: 2,
+		},
+		{name: "unwrap", args: []string{"span"}, line: 3},
+	}
+
+	actual := parseRules(rulesText)
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf(`Parsed rules do not match expected rules: got %v instead of %v`, actual, expected)
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	scenarios := []struct {
+		rules   string
+		line    int
+		message string
+	}{
+		{"", 0, ""},
+		{`add_image_title, remove(".ads"), base64_decode`, 0, ""},
+		{"add_image_title\nif_url(\"^https://example\\\\.org/\") replace_attribute(\"img\"|\"src\"|\"-small\"|\"-large\")", 0, ""},
+		{"add_image_title\nunknown_rule", 2, `unknown rule "unknown_rule"`},
+		{"add_image_title\n\nremove", 3, `"remove" expects 1 argument(s), got 0`},
+		{`base64_decode("p"|"div")`, 1, `"base64_decode" expects 0 to 1 argument(s), got 2`},
+		{"replace(\"(\"|\"\")", 1, `"replace" has an invalid regular expression "("`},
+		{"add_image_title\nremove(\"<>\")", 2, `"remove" has an invalid CSS selector "<>"`},
+		{"add_image_title\nif_url(\"[\") remove(\"p\")", 2, `"if_url" has an invalid regular expression "["`},
+		{"add_image_title\nif_selector(\"p\")", 2, `condition "if_selector" is not followed by a rule`},
+		{`"orphan", add_image_title`, 1, `argument "orphan" is not attached to a rule`},
+		{"add_image_title\nremove(\"p)", 2, `literal not terminated`},
+	}
+
+	for _, scenario := range scenarios {
+		err := ValidateRules(scenario.rules)
+		if scenario.message == "" {
+			if err != nil {
+				t.Errorf(`The rules %q should be valid, got %v`, scenario.rules, err)
+			}
+			continue
+		}
+
+		if err == nil {
+			t.Errorf(`The rules %q should be invalid`, scenario.rules)
+			continue
+		}
+
+		if err.Line != scenario.line || err.Message != scenario.message {
+			t.Errorf(`Unexpected error for %q, got %q instead of "line %d: %s"`, scenario.rules, err.Error(), scenario.line, scenario.message)
+		}
+	}
+}
+
+func TestPredefinedRulesAreValid(t *testing.T) {
+	for domain, rules := range predefinedRules {
+		if err := ValidateRules(rules); err != nil {
+			t.Errorf(`The predefined rules of %q are invalid: %v`, domain, err)
+		}
+	}
+}
+
+func TestConditionalRules(t *testing.T) {
+	rules := `if_url("/news/") remove(".ads"), if_selector("video") remove(".placeholder")`
+
+	entry := &model.Entry{Content: `<p>Text</p><div class="ads">Ads</div><div class="placeholder">Video</div>`}
+	Rewriter("https://example.org/blog/1", entry, rules)
+	if entry.Content != `<p>Text</p><div class="ads">Ads</div><div class="placeholder">Video</div>` {
+		t.Errorf(`The rules should not be applied, got %q`, entry.Content)
+	}
+
+	entry = &model.Entry{Content: `<p>Text</p><div class="ads">Ads</div><div class="placeholder">Video</div><video></video>`}
+	Rewriter("https://example.org/news/1", entry, rules)
+	if entry.Content != `<p>Text</p><video></video>` {
+		t.Errorf(`The rules should be applied, got %q`, entry.Content)
+	}
+}
+
+func TestAttributeRules(t *testing.T) {
+	entry := &model.Entry{Content: `<img class="lazy" data-src="https://example.org/image-small.jpg" width="10"/>`}
+	rules := `rename_attribute("img.lazy"|"data-src"|"src")
+replace_attribute("img"|"src"|"-small\\."|"-large.")
+set_attribute("img"|"loading"|"lazy")
+remove_attribute("img"|"width")`
+
+	Rewriter("https://example.org/", entry, rules)
+
+	expected := `<img class="lazy" loading="lazy" src="https://example.org/image-large.jpg"/>`
+	if entry.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, entry.Content, expected)
+	}
+}
+
+func TestWrapAndUnwrapRules(t *testing.T) {
+	entry := &model.Entry{Content: `<div class="wrapper"><img src="a.jpg"/><span>Text</span></div>`}
+	Rewriter("https://example.org/", entry, `unwrap("div.wrapper, span"), wrap("img"|"<figure></figure>")`)
+
+	expected := `<figure><img src="a.jpg"/></figure>Text`
+	if entry.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, entry.Content, expected)
+	}
+}
+
+func TestMoveRules(t *testing.T) {
+	entry := &model.Entry{Content: `<p>First</p><p>Second</p><figure>Image</figure><aside>Note</aside>`}
+	Rewriter("https://example.org/", entry, `move_to_top("figure"), move_to_bottom("p:first-of-type")`)
+
+	expected := `<figure>Image</figure><p>Second</p><aside>Note</aside><p>First</p>`
+	if entry.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, entry.Content, expected)
+	}
+}
+
+func TestEntryMetadataRules(t *testing.T) {
+	entry := &model.Entry{
+		Author: "Feed Author",
+		Tags:   []string{"feed"},
+		Content: `<p class="author"> Jane
+			Doe </p><time datetime="2023-06-01T10:00:00Z">June 1st</time><a rel="tag">Go</a><a rel="tag">Web</a><a rel="tag">Go</a>`,
+	}
+
+	Rewriter("https://example.org/", entry, `set_author(".author"), set_date("time"), set_tags("a[rel=tag]"), set_author(".missing")`)
+
+	if entry.Author != "Jane Doe" {
+		t.Errorf(`Unexpected author, got %q`, entry.Author)
+	}
+
+	if !entry.Date.Equal(time.Date(2023, time.June, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf(`Unexpected date, got %v`, entry.Date)
+	}
+
+	if !reflect.DeepEqual(entry.Tags, []string{"Go", "Web"}) {
+		t.Errorf(`Unexpected tags, got %v`, entry.Tags)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"miniflux.app/v2/internal/crypto"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/date"
+	"miniflux.app/v2/internal/reader/podcast"
 	"miniflux.app/v2/internal/reader/sanitizer"
 	"miniflux.app/v2/internal/urllib"
 )
@@ -49,6 +50,17 @@ func (r *RSSAdapter) BuildFeed(baseURL string) *model.Feed {
 		}
 	}
 
+	// Find the WebSub hub URL from the Atom links.
+	for _, atomLink := range r.rss.Channel.AtomLinks.Links {
+		atomLinkHref := strings.TrimSpace(atomLink.Href)
+		if atomLinkHref != "" && atomLink.Rel == "hub" {
+			if absoluteHubURL, err := urllib.AbsoluteURL(baseURL, atomLinkHref); err == nil {
+				feed.HubURL = absoluteHubURL
+				break
+			}
+		}
+	}
+
 	// Fallback to the site URL if the title is empty.
 	if feed.Title == "" {
 		feed.Title = feed.SiteURL
@@ -119,6 +131,15 @@ func (r *RSSAdapter) BuildFeed(baseURL string) *model.Feed {
 			}
 		}
 
+		// Populate the Podcasting 2.0 information, iTunes season and episode are used as fallback.
+		entry.Podcast = podcast.Metadata(&r.rss.Channel.PodcastChannelElement, &item.PodcastItemElement, feed.SiteURL)
+		if entry.Podcast.Season == "" {
+			entry.Podcast.Season = strings.TrimSpace(item.ItunesSeason)
+		}
+		if entry.Podcast.Episode == "" {
+			entry.Podcast.Episode = strings.TrimSpace(item.ItunesEpisode)
+		}
+
 		// Populate entry categories.
 		for _, tag := range item.Categories {
 			if tag != "" {
@@ -311,9 +332,11 @@ func findEntryEnclosures(rssItem *RSSItem, siteURL string) model.EnclosureList {
 			duplicates[enclosureURL] = true
 
 			enclosures = append(enclosures, &model.Enclosure{
-				URL:      enclosureURL,
-				MimeType: enclosure.Type,
-				Size:     enclosure.Size(),
+				URL:          enclosureURL,
+				MimeType:     enclosure.Type,
+				Size:         enclosure.Size(),
+				Duration:     findEnclosureDuration(rssItem, enclosure.Type),
+				ThumbnailURL: findEnclosureThumbnailURL(rssItem, "", siteURL),
 			})
 		}
 	}
@@ -323,23 +346,36 @@ func findEntryEnclosures(rssItem *RSSItem, siteURL string) model.EnclosureList {
 		if mediaURL == "" {
 			continue
 		}
-		if _, found := duplicates[mediaURL]; !found {
-			mediaURL := strings.TrimSpace(mediaContent.URL)
-			if mediaAbsoluteURL, err := urllib.AbsoluteURL(siteURL, mediaURL); err != nil {
-				slog.Debug("Unable to build absolute URL for media content",
-					slog.String("url", mediaContent.URL),
-					slog.String("site_url", siteURL),
-					slog.Any("error", err),
-				)
-			} else {
-				duplicates[mediaAbsoluteURL] = true
-				enclosures = append(enclosures, &model.Enclosure{
-					URL:      mediaAbsoluteURL,
-					MimeType: mediaContent.MimeType(),
-					Size:     mediaContent.Size(),
-				})
-			}
+		mediaAbsoluteURL, err := urllib.AbsoluteURL(siteURL, mediaURL)
+		if err != nil {
+			slog.Debug("Unable to build absolute URL for media content",
+				slog.String("url", mediaContent.URL),
+				slog.String("site_url", siteURL),
+				slog.Any("error", err),
+			)
+			continue
+		}
+
+		width, height := mediaContent.Dimensions()
+		mediaEnclosure := &model.Enclosure{
+			URL:          mediaAbsoluteURL,
+			MimeType:     mediaContent.MimeType(),
+			Size:         mediaContent.Size(),
+			Duration:     mediaContent.DurationInSeconds(),
+			Width:        width,
+			Height:       height,
+			ThumbnailURL: findEnclosureThumbnailURL(rssItem, mediaContent.ThumbnailURL(), siteURL),
+			Title:        mediaContent.Title(),
+		}
+
+		// The same file is often published as enclosure and as media content, the media content has more details.
+		if _, found := duplicates[mediaAbsoluteURL]; found {
+			mergeEnclosureMetadata(enclosures, mediaEnclosure)
+			continue
 		}
+
+		duplicates[mediaAbsoluteURL] = true
+		enclosures = append(enclosures, mediaEnclosure)
 	}
 
 	for _, mediaPeerLink := range rssItem.AllMediaPeerLinks() {
@@ -368,3 +404,61 @@ func findEntryEnclosures(rssItem *RSSItem, siteURL string) model.EnclosureList {
 
 	return enclosures
 }
+
+// findEnclosureDuration returns the iTunes duration of the item in seconds for audio and video enclosures.
+func findEnclosureDuration(rssItem *RSSItem, mimeType string) int {
+	if rssItem.ItunesDuration == "" || !(strings.HasPrefix(mimeType, "audio/") || strings.HasPrefix(mimeType, "video/")) {
+		return 0
+	}
+
+	duration, err := getDurationInSeconds(rssItem.ItunesDuration)
+	if err != nil {
+		return 0
+	}
+
+	return duration
+}
+
+// findEnclosureThumbnailURL returns the thumbnail of the media, or the image of the episode.
+func findEnclosureThumbnailURL(rssItem *RSSItem, mediaThumbnailURL, siteURL string) string {
+	candidates := []string{mediaThumbnailURL, rssItem.ItunesImage.Href}
+	for _, mediaThumbnail := range rssItem.AllMediaThumbnails() {
+		candidates = append(candidates, mediaThumbnail.URL)
+	}
+
+	for _, candidate := range candidates {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "" {
+			continue
+		}
+
+		if absoluteURL, err := urllib.AbsoluteURL(siteURL, candidate); err == nil {
+			return absoluteURL
+		}
+	}
+
+	return ""
+}
+
+// mergeEnclosureMetadata fills the missing details of the enclosure having the same URL.
+func mergeEnclosureMetadata(enclosures model.EnclosureList, other *model.Enclosure) {
+	for _, enclosure := range enclosures {
+		if enclosure.URL != other.URL {
+			continue
+		}
+
+		if enclosure.Duration == 0 {
+			enclosure.Duration = other.Duration
+		}
+		if enclosure.Width == 0 && enclosure.Height == 0 {
+			enclosure.Width, enclosure.Height = other.Width, other.Height
+		}
+		if enclosure.Title == "" {
+			enclosure.Title = other.Title
+		}
+		if other.ThumbnailURL != "" && enclosure.ThumbnailURL == "" {
+			enclosure.ThumbnailURL = other.ThumbnailURL
+		}
+		return
+	}
+}
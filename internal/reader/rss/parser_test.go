@@ -157,6 +157,35 @@ func TestParseFeedWithRelativeFeedURL(t *testing.T) {
 	}
 }
 
+func TestParseFeedHubURL(t *testing.T) {
+	data := `<?xml version="1.0"?>
+		<rss version="2.0" xmlns:atom="http://www.w3.org/2005/Atom">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<atom:link href="https://example.org/rss" type="application/rss+xml" rel="self"></atom:link>
+			<atom:link href="https://hub.example.org/" rel="hub"></atom:link>
+			<item>
+				<title>Test</title>
+				<link>https://example.org/item</link>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.HubURL != "https://hub.example.org/" {
+		t.Errorf("Incorrect hub URL, got: %s", feed.HubURL)
+	}
+
+	if feed.FeedURL != "https://example.org/rss" {
+		t.Errorf("Incorrect feed URL, got: %s", feed.FeedURL)
+	}
+}
+
 func TestParseFeedSiteURLWithTrailingSpace(t *testing.T) {
 	data := `<?xml version="1.0"?>
 		<rss version="2.0">
@@ -651,6 +680,92 @@ func TestParseEntryWithItunesAuthor(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithPodcastNamespace(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:podcast="https://podcastindex.org/namespace/1.0">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<podcast:funding url="https://example.org/donate">Support the show</podcast:funding>
+			<podcast:person role="host" img="/host.jpg">Jane Host</podcast:person>
+			<item>
+				<title>Episode 3</title>
+				<link>https://example.org/episode-3</link>
+				<podcast:season>2</podcast:season>
+				<podcast:episode display="Ch.3">3</podcast:episode>
+				<podcast:transcript url="/episode-3.vtt" type="text/vtt" language="en" rel="captions" />
+				<podcast:transcript url="https://example.org/episode-3.html" type="text/html" />
+				<podcast:chapters url="/episode-3.json" type="application/json+chapters" />
+			</item>
+			<item>
+				<title>Episode 2</title>
+				<link>https://example.org/episode-2</link>
+				<podcast:person role="Guest" href="https://example.com/">John Guest</podcast:person>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	podcast := feed.Entries[0].Podcast
+	if podcast.Season != "2" || podcast.Episode != "Ch.3" {
+		t.Errorf("Incorrect season or episode, got %q and %q", podcast.Season, podcast.Episode)
+	}
+
+	if podcast.ChaptersURL != "https://example.org/episode-3.json" || podcast.ChaptersType != "application/json+chapters" {
+		t.Errorf("Incorrect chapters, got %q and %q", podcast.ChaptersURL, podcast.ChaptersType)
+	}
+
+	if len(podcast.Transcripts) != 2 {
+		t.Fatalf("Incorrect number of transcripts, got %d", len(podcast.Transcripts))
+	}
+
+	if podcast.Transcripts[0].URL != "https://example.org/episode-3.vtt" || podcast.Transcripts[0].Language != "en" || podcast.Transcripts[0].Rel != "captions" {
+		t.Errorf("Incorrect transcript, got %+v", podcast.Transcripts[0])
+	}
+
+	if len(podcast.Persons) != 1 || podcast.Persons[0].Name != "Jane Host" || podcast.Persons[0].Image != "https://example.org/host.jpg" {
+		t.Errorf("Incorrect persons, got %+v", podcast.Persons)
+	}
+
+	if len(podcast.Funding) != 1 || podcast.Funding[0].Title != "Support the show" {
+		t.Errorf("Incorrect funding, got %+v", podcast.Funding)
+	}
+
+	persons := feed.Entries[1].Podcast.Persons
+	if len(persons) != 1 || persons[0].Name != "John Guest" || persons[0].Role != "guest" {
+		t.Errorf("Incorrect persons, got %+v", persons)
+	}
+}
+
+func TestParseEntryWithItunesSeasonAndEpisode(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Example</title>
+			<link>https://example.org/</link>
+			<item>
+				<title>Test</title>
+				<link>https://example.org/item</link>
+				<itunes:season>4</itunes:season>
+				<itunes:episode>12</itunes:episode>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if feed.Entries[0].Podcast.Season != "4" || feed.Entries[0].Podcast.Episode != "12" {
+		t.Errorf("Incorrect season or episode, got %+v", feed.Entries[0].Podcast)
+	}
+}
+
 func TestParseFeedWithItunesAuthor(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
@@ -1599,6 +1714,95 @@ func TestParseEntryWithMediaContent(t *testing.T) {
 	}
 }
 
+func TestParseEntryWithMediaContentMetadata(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
+		<channel>
+		<title>My Example Feed</title>
+		<link>https://example.org</link>
+		<item>
+			<title>Example Item</title>
+			<link>http://www.example.org/entries/1</link>
+			<enclosure url="https://example.org/video.mp4" length="1024" type="video/mp4"/>
+			<media:content url="https://example.org/video.mp4" type="video/mp4" duration="125" width="1280" height="720">
+				<media:title>Video Title</media:title>
+				<media:thumbnail url="/poster.jpg" />
+			</media:content>
+		</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 1 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	enclosure := feed.Entries[0].Enclosures[0]
+	if enclosure.Size != 1024 {
+		t.Errorf(`Unexpected enclosure size, got %d`, enclosure.Size)
+	}
+
+	if enclosure.Duration != 125 {
+		t.Errorf(`Unexpected enclosure duration, got %d`, enclosure.Duration)
+	}
+
+	if enclosure.Width != 1280 || enclosure.Height != 720 {
+		t.Errorf(`Unexpected enclosure dimensions, got %dx%d`, enclosure.Width, enclosure.Height)
+	}
+
+	if enclosure.Title != "Video Title" {
+		t.Errorf(`Unexpected enclosure title, got %q`, enclosure.Title)
+	}
+
+	if enclosure.ThumbnailURL != "https://example.org/poster.jpg" {
+		t.Errorf(`Unexpected enclosure thumbnail, got %q`, enclosure.ThumbnailURL)
+	}
+}
+
+func TestParseEnclosureWithItunesDurationAndImage(t *testing.T) {
+	data := `<?xml version="1.0" encoding="UTF-8"?>
+		<rss version="2.0" xmlns:itunes="http://www.itunes.com/dtds/podcast-1.0.dtd">
+		<channel>
+			<title>Podcast Example</title>
+			<link>http://www.example.com/index.html</link>
+			<item>
+				<title>Podcast Episode</title>
+				<guid>http://example.com/episode.m4a</guid>
+				<enclosure url="http://example.com/episode.m4a" length="1024" type="audio/x-m4a"/>
+				<enclosure url="http://example.com/cover.jpg" type="image/jpeg"/>
+				<itunes:duration>1:02:03</itunes:duration>
+				<itunes:image href="http://example.com/episode.jpg"/>
+			</item>
+		</channel>
+		</rss>`
+
+	feed, err := Parse("https://example.org/", bytes.NewReader([]byte(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(feed.Entries[0].Enclosures) != 2 {
+		t.Fatalf("Incorrect number of enclosures, got: %d", len(feed.Entries[0].Enclosures))
+	}
+
+	audio := feed.Entries[0].Enclosures[0]
+	if audio.Duration != 3723 {
+		t.Errorf(`Unexpected enclosure duration, got %d instead of %d`, audio.Duration, 3723)
+	}
+
+	if audio.ThumbnailURL != "http://example.com/episode.jpg" {
+		t.Errorf(`Unexpected enclosure thumbnail, got %q`, audio.ThumbnailURL)
+	}
+
+	if image := feed.Entries[0].Enclosures[1]; image.Duration != 0 {
+		t.Errorf(`Unexpected image duration, got %d`, image.Duration)
+	}
+}
+
 func TestParseEntryWithMediaPeerLink(t *testing.T) {
 	data := `<?xml version="1.0" encoding="utf-8"?>
 		<rss version="2.0" xmlns:media="http://search.yahoo.com/mrss/">
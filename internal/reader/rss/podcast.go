@@ -13,9 +13,18 @@ import (
 var ErrInvalidDurationFormat = errors.New("rss: invalid duration format")
 
 func getDurationInMinutes(rawDuration string) (int, error) {
+	seconds, err := getDurationInSeconds(rawDuration)
+	if err != nil {
+		return 0, err
+	}
+
+	return seconds / 60, nil
+}
+
+func getDurationInSeconds(rawDuration string) (int, error) {
 	var sumSeconds int
 
-	durationParts := strings.Split(rawDuration, ":")
+	durationParts := strings.Split(strings.TrimSpace(rawDuration), ":")
 	if len(durationParts) > 3 {
 		return 0, ErrInvalidDurationFormat
 	}
@@ -29,5 +38,5 @@ func getDurationInMinutes(rawDuration string) (int, error) {
 		sumSeconds += int(math.Pow(60, float64(len(durationParts)-i-1))) * durationPartValue
 	}
 
-	return sumSeconds / 60, nil
+	return sumSeconds, nil
 }
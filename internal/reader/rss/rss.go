@@ -12,6 +12,7 @@ import (
 	"miniflux.app/v2/internal/reader/googleplay"
 	"miniflux.app/v2/internal/reader/itunes"
 	"miniflux.app/v2/internal/reader/media"
+	"miniflux.app/v2/internal/reader/podcast"
 )
 
 // Specs: https://www.rssboard.org/rss-specification
@@ -88,6 +89,7 @@ type RSSChannel struct {
 	AtomLinks
 	itunes.ItunesChannelElement
 	googleplay.GooglePlayChannelElement
+	podcast.PodcastChannelElement
 }
 
 type RSSCloud struct {
@@ -167,6 +169,7 @@ type RSSItem struct {
 	AtomLinks
 	itunes.ItunesItemElement
 	googleplay.GooglePlayItemElement
+	podcast.PodcastItemElement
 }
 
 type RSSAuthor struct {
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package scraper // import "miniflux.app/v2/internal/reader/scraper"
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	pageCacheTTL        = 5 * time.Minute
+	pageCacheMaxEntries = 100
+	pageCacheMaxBytes   = 20 * 1024 * 1024
+
+	// Larger pages are not cached, they are downloaded again for each preview.
+	pageCacheMaxPageSize = 2 * 1024 * 1024
+)
+
+// previewCache keeps the downloaded pages while the user is testing scraper rules.
+var previewCache = newPageCache(pageCacheTTL, pageCacheMaxEntries, pageCacheMaxBytes, pageCacheMaxPageSize)
+
+type cachedPage struct {
+	effectiveURL string
+	page         []byte
+	expiresAt    time.Time
+}
+
+// pageCache is a short-lived in-memory cache of downloaded pages,
+// limited by the number of pages and by their total size.
+type pageCache struct {
+	mu          sync.Mutex
+	ttl         time.Duration
+	maxEntries  int
+	maxBytes    int
+	maxPageSize int
+	size        int
+	pages       map[string]*cachedPage
+}
+
+func newPageCache(ttl time.Duration, maxEntries, maxBytes, maxPageSize int) *pageCache {
+	return &pageCache{
+		ttl:         ttl,
+		maxEntries:  maxEntries,
+		maxBytes:    maxBytes,
+		maxPageSize: min(maxPageSize, maxBytes),
+		pages:       make(map[string]*cachedPage),
+	}
+}
+
+func (c *pageCache) get(key string) (*cachedPage, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	page, found := c.pages[key]
+	if !found {
+		return nil, false
+	}
+
+	if time.Now().After(page.expiresAt) {
+		c.remove(key)
+		return nil, false
+	}
+
+	return page, true
+}
+
+func (c *pageCache) set(key, effectiveURL string, page []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.remove(key)
+	if len(page) > c.maxPageSize {
+		return
+	}
+
+	now := time.Now()
+	if c.isFull(len(page)) {
+		for cacheKey, cachedPage := range c.pages {
+			if now.After(cachedPage.expiresAt) {
+				c.remove(cacheKey)
+			}
+		}
+	}
+
+	// The oldest pages are removed until the new page fits in the cache.
+	for c.isFull(len(page)) {
+		var oldestKey string
+		var oldestExpiration time.Time
+
+		for cacheKey, cachedPage := range c.pages {
+			if oldestKey == "" || cachedPage.expiresAt.Before(oldestExpiration) {
+				oldestKey = cacheKey
+				oldestExpiration = cachedPage.expiresAt
+			}
+		}
+
+		c.remove(oldestKey)
+	}
+
+	c.pages[key] = &cachedPage{
+		effectiveURL: effectiveURL,
+		page:         page,
+		expiresAt:    now.Add(c.ttl),
+	}
+	c.size += len(page)
+}
+
+// isFull returns true when a page of the given size can't be added without removing other pages.
+func (c *pageCache) isFull(pageSize int) bool {
+	return len(c.pages) > 0 && (len(c.pages) >= c.maxEntries || c.size+pageSize > c.maxBytes)
+}
+
+func (c *pageCache) remove(key string) {
+	if cachedPage, found := c.pages[key]; found {
+		c.size -= len(cachedPage.page)
+		delete(c.pages, key)
+	}
+}
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package scraper // import "miniflux.app/v2/internal/reader/scraper"
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/reader/fetcher"
+)
+
+func TestPageCacheExpiration(t *testing.T) {
+	cache := newPageCache(time.Minute, 10, 1024, 1024)
+	cache.set("key", "https://example.org/", []byte("page"))
+
+	page, found := cache.get("key")
+	if !found || page.effectiveURL != "https://example.org/" || string(page.page) != "page" {
+		t.Fatalf(`The page should be in the cache, got %+v`, page)
+	}
+
+	cache.pages["key"].expiresAt = time.Now().Add(-time.Second)
+	if _, found := cache.get("key"); found {
+		t.Error(`An expired page should not be returned`)
+	}
+
+	if len(cache.pages) != 0 {
+		t.Error(`An expired page should be removed from the cache`)
+	}
+}
+
+func TestPageCacheMaxEntries(t *testing.T) {
+	cache := newPageCache(time.Minute, 2, 1024, 1024)
+	cache.set("first", "https://example.org/1", nil)
+	cache.set("second", "https://example.org/2", nil)
+	cache.set("third", "https://example.org/3", nil)
+
+	if len(cache.pages) != 2 {
+		t.Fatalf(`Unexpected number of cached pages, got %d`, len(cache.pages))
+	}
+
+	if _, found := cache.get("first"); found {
+		t.Error(`The oldest page should be removed when the cache is full`)
+	}
+
+	if _, found := cache.get("third"); !found {
+		t.Error(`The last page should be in the cache`)
+	}
+}
+
+func TestPageCacheMaxBytes(t *testing.T) {
+	cache := newPageCache(time.Minute, 10, 10, 6)
+	cache.set("first", "https://example.org/1", []byte("1234"))
+	cache.set("second", "https://example.org/2", []byte("1234"))
+	cache.set("third", "https://example.org/3", []byte("1234"))
+
+	if _, found := cache.get("first"); found {
+		t.Error(`The oldest page should be removed when the cache is too large`)
+	}
+
+	if len(cache.pages) != 2 || cache.size != 8 {
+		t.Fatalf(`Unexpected cache state, got %d pages and %d bytes`, len(cache.pages), cache.size)
+	}
+
+	cache.set("second", "https://example.org/2", []byte("12"))
+	if cache.size != 6 {
+		t.Errorf(`The size of a replaced page should not be counted twice, got %d bytes`, cache.size)
+	}
+}
+
+func TestPageCacheMaxPageSize(t *testing.T) {
+	cache := newPageCache(time.Minute, 10, 1024, 4)
+	cache.set("small", "https://example.org/1", []byte("1234"))
+	cache.set("large", "https://example.org/2", []byte("12345"))
+
+	if _, found := cache.get("large"); found {
+		t.Error(`A page larger than the limit should not be cached`)
+	}
+
+	if _, found := cache.get("small"); !found || cache.size != 4 {
+		t.Errorf(`The small page should be kept in the cache, got %d bytes`, cache.size)
+	}
+}
+
+func TestScrapeWebsiteWithCache(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><article><p>Title</p><p>Content</p></article></body></html>`)
+	}))
+	defer server.Close()
+
+	for _, rules := range []string{"article", "article p:first-child", "article p:last-child"} {
+		if _, err := ScrapeWebsiteWithCache(fetcher.NewRequestBuilder(), "1:1", server.URL, rules); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if requestCount != 1 {
+		t.Errorf(`The page should be downloaded only once, got %d requests`, requestCount)
+	}
+
+	if _, err := ScrapeWebsiteWithCache(fetcher.NewRequestBuilder(), "2:1", server.URL, "article"); err != nil {
+		t.Fatal(err)
+	}
+
+	if requestCount != 2 {
+		t.Errorf(`The cache should not be shared between namespaces, got %d requests`, requestCount)
+	}
+}
@@ -4,6 +4,8 @@
 package scraper // import "miniflux.app/v2/internal/reader/scraper"
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -15,59 +17,331 @@ import (
 	"miniflux.app/v2/internal/urllib"
 
 	"github.com/PuerkitoBio/goquery"
+	"github.com/andybalholm/cascadia"
 	"golang.org/x/net/html/charset"
 )
 
-func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, websiteURL, rules string) (string, error) {
-	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(websiteURL))
-	defer responseHandler.Close()
+// Content extraction strategies, in order of preference.
+const (
+	StrategyCustomRules       = "custom_rules"
+	StrategyPredefinedRules   = "predefined_rules"
+	StrategyReadability       = "readability"
+	StrategyLegacyReadability = "legacy_readability"
+)
 
-	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
-		slog.Warn("Unable to scrape website", slog.String("website_url", websiteURL), slog.Any("error", localizedError.Error()))
-		return "", localizedError.Error()
+// Below this number of characters, the result of a readability extractor is compared with the next extractors.
+const minReadabilityTextLength = 250
+
+var errNoMatchingContent = errors.New("scraper: no content matches the rules")
+
+// contentExtractor is a content extraction strategy.
+// Its result is kept when the text is at least minTextLength characters long,
+// otherwise the next extractors are tried and the longest result wins.
+type contentExtractor struct {
+	strategy      string
+	extractor     readability.Extractor
+	minTextLength int
+}
+
+// rulesExtractor returns the elements matching the CSS selector of scraper rules.
+type rulesExtractor struct {
+	rules string
+}
+
+// Extract implements the readability.Extractor interface.
+func (e rulesExtractor) Extract(pageURL string, page io.Reader) (*readability.Article, error) {
+	content, err := findContentUsingCustomRules(page, e.rules)
+	if err != nil {
+		return nil, err
 	}
 
-	if !isAllowedContentType(responseHandler.ContentType()) {
-		return "", fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
+	if content == "" {
+		return nil, errNoMatchingContent
+	}
+
+	return &readability.Article{Content: content}, nil
+}
+
+// Scraper rules are a CSS selector, optionally followed by directives separated by semicolons.
+// The "next_page" directive selects the link to the next page of a multi-page article,
+// for example: "div.article-body; next_page: a.pagination-next".
+const nextPageDirective = "next_page:"
+
+// pageFetcher downloads a page and returns its URL after redirects and its content.
+type pageFetcher func(pageURL string) (string, []byte, error)
+
+// ScrapeWebsite downloads the website and returns its main content, with the metadata found by the readability extractor.
+// When the article is split into several pages, the content of the next pages is appended.
+func ScrapeWebsite(requestBuilder *fetcher.RequestBuilder, websiteURL, rules string) (*readability.Article, error) {
+	return scrapeWebsite(func(pageURL string) (string, []byte, error) {
+		return fetchPage(requestBuilder, pageURL)
+	}, websiteURL, rules)
+}
+
+// ScrapeWebsiteWithCache works like ScrapeWebsite, but the downloaded pages are kept in memory for a few minutes.
+// The cache namespace should identify the user and the request settings, because pages could be fetched with cookies.
+func ScrapeWebsiteWithCache(requestBuilder *fetcher.RequestBuilder, cacheNamespace, websiteURL, rules string) (*readability.Article, error) {
+	return scrapeWebsite(func(pageURL string) (string, []byte, error) {
+		cacheKey := cacheNamespace + ":" + pageURL
+		if cachedPage, found := previewCache.get(cacheKey); found {
+			slog.Debug("Using cached page", slog.String("page_url", pageURL))
+			return cachedPage.effectiveURL, cachedPage.page, nil
+		}
+
+		effectiveURL, page, err := fetchPage(requestBuilder, pageURL)
+		if err != nil {
+			return "", nil, err
+		}
+
+		previewCache.set(cacheKey, effectiveURL, page)
+		return effectiveURL, page, nil
+	}, websiteURL, rules)
+}
+
+func scrapeWebsite(fetch pageFetcher, websiteURL, rules string) (*readability.Article, error) {
+	contentRules, nextPageRules := parseScraperRules(rules)
+	if nextPageRules == "" {
+		_, nextPageRules = parseScraperRules(getPredefinedScraperRules(websiteURL))
+	}
+	maxPages := max(config.Opts.ScraperMaxPages(), 1)
+
+	var article *readability.Article
+	var contents strings.Builder
+	visitedURLs := make(map[string]bool)
+	firstPageURL := ""
+	pageURL := websiteURL
+
+	for pageNumber := 1; pageNumber <= maxPages && pageURL != ""; pageNumber++ {
+		effectiveURL, page, err := fetch(pageURL)
+		if err != nil {
+			if pageNumber == 1 {
+				return nil, err
+			}
+
+			slog.Warn("Unable to scrape the next page of the article",
+				slog.String("website_url", websiteURL),
+				slog.String("page_url", pageURL),
+				slog.Any("error", err),
+			)
+			break
+		}
+
+		if pageNumber == 1 {
+			firstPageURL = effectiveURL
+		}
+		visitedURLs[pageURL] = true
+		visitedURLs[effectiveURL] = true
+
+		// The entry URL could redirect somewhere else.
+		sameSite := urllib.Domain(websiteURL) == urllib.Domain(effectiveURL)
+
+		pageArticle, strategy, err := extractContent(effectiveURL, page, sameSite, contentRules)
+		if err != nil {
+			if pageNumber == 1 {
+				return nil, err
+			}
+			break
+		}
+
+		slog.Debug("Extracted website content",
+			slog.String("website_url", websiteURL),
+			slog.String("page_url", effectiveURL),
+			slog.Int("page_number", pageNumber),
+			slog.String("strategy", strategy),
+		)
+
+		// The metadata of the article are found on the first page.
+		// The sanitizer resolves relative URLs against the entry URL, which is only correct for the first page.
+		if pageNumber == 1 {
+			article = pageArticle
+			contents.WriteString(pageArticle.Content)
+		} else {
+			contents.WriteString(resolveRelativeURLs(effectiveURL, pageArticle.Content))
+		}
+
+		pageURL = findNextPageURL(effectiveURL, page, nextPageRules)
+		if pageURL != "" && (visitedURLs[pageURL] || urllib.Domain(pageURL) != urllib.Domain(firstPageURL)) {
+			pageURL = ""
+		}
 	}
 
-	// The entry URL could redirect somewhere else.
-	sameSite := urllib.Domain(websiteURL) == urllib.Domain(responseHandler.EffectiveURL())
-	websiteURL = responseHandler.EffectiveURL()
+	article.Content = contents.String()
+	return article, nil
+}
+
+// fetchPage downloads a HTML page and returns its URL after redirects and its content converted to UTF-8.
+func fetchPage(requestBuilder *fetcher.RequestBuilder, pageURL string) (string, []byte, error) {
+	responseHandler := fetcher.NewResponseHandler(requestBuilder.ExecuteRequest(pageURL))
+	defer responseHandler.Close()
 
-	if rules == "" {
-		rules = getPredefinedScraperRules(websiteURL)
+	if localizedError := responseHandler.LocalizedError(); localizedError != nil {
+		slog.Warn("Unable to scrape website", slog.String("website_url", pageURL), slog.Any("error", localizedError.Error()))
+		return "", nil, localizedError.Error()
 	}
 
-	var content string
-	var err error
+	if !isAllowedContentType(responseHandler.ContentType()) {
+		return "", nil, fmt.Errorf("scraper: this resource is not a HTML document (%s)", responseHandler.ContentType())
+	}
 
 	htmlDocumentReader, err := charset.NewReader(
 		responseHandler.Body(config.Opts.HTTPClientMaxBodySize()),
 		responseHandler.ContentType(),
 	)
 	if err != nil {
-		return "", fmt.Errorf("scraper: unable to read HTML document: %v", err)
+		return "", nil, fmt.Errorf("scraper: unable to read HTML document: %v", err)
 	}
 
-	if sameSite && rules != "" {
-		slog.Debug("Extracting content with custom rules",
-			"url", websiteURL,
-			"rules", rules,
-		)
-		content, err = findContentUsingCustomRules(htmlDocumentReader, rules)
-	} else {
-		slog.Debug("Extracting content with readability",
-			"url", websiteURL,
-		)
-		content, err = readability.ExtractContent(htmlDocumentReader)
+	page, err := io.ReadAll(htmlDocumentReader)
+	if err != nil {
+		return "", nil, fmt.Errorf("scraper: unable to read HTML document: %v", err)
+	}
+
+	return responseHandler.EffectiveURL(), page, nil
+}
+
+// parseScraperRules splits the scraper rules into the content selector and the next page selector.
+func parseScraperRules(rules string) (contentRules, nextPageRules string) {
+	parts := strings.Split(rules, ";")
+	contentRules = strings.TrimSpace(parts[0])
+
+	for _, part := range parts[1:] {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, nextPageDirective) {
+			nextPageRules = strings.TrimSpace(strings.TrimPrefix(part, nextPageDirective))
+		}
+	}
+
+	return contentRules, nextPageRules
+}
+
+// ValidateRules checks that the content selector and the next page selector of the scraper rules can be compiled.
+func ValidateRules(rules string) error {
+	contentRules, nextPageRules := parseScraperRules(rules)
+	for _, selector := range []string{contentRules, nextPageRules} {
+		if selector == "" {
+			continue
+		}
+
+		if _, err := cascadia.Compile(selector); err != nil {
+			return fmt.Errorf("invalid CSS selector %q", selector)
+		}
 	}
 
+	return nil
+}
+
+// findNextPageURL returns the absolute URL of the next page, found with the given selector or with a <link rel="next"> element.
+// Blogs often use <a rel="next"> for the link to the next post, these links are followed only with an explicit selector.
+func findNextPageURL(pageURL string, page []byte, nextPageRules string) string {
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
 	if err != nil {
-		return "", err
+		return ""
+	}
+
+	selector := `link[rel~="next"][href]`
+	if nextPageRules != "" {
+		selector = nextPageRules
+	}
+
+	href, _ := document.Find(selector).First().Attr("href")
+	href = strings.TrimSpace(href)
+	if href == "" || strings.HasPrefix(href, "#") {
+		return ""
+	}
+
+	nextPageURL, err := urllib.AbsoluteURL(pageURL, href)
+	if err != nil || !(strings.HasPrefix(nextPageURL, "https://") || strings.HasPrefix(nextPageURL, "http://")) {
+		return ""
+	}
+
+	return nextPageURL
+}
+
+func resolveRelativeURLs(pageURL, content string) string {
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return content
+	}
+
+	for _, attribute := range []string{"href", "src", "poster"} {
+		document.Find("[" + attribute + "]").Each(func(i int, s *goquery.Selection) {
+			value, _ := s.Attr(attribute)
+			if absoluteURL, err := urllib.AbsoluteURL(pageURL, strings.TrimSpace(value)); err == nil {
+				s.SetAttr(attribute, absoluteURL)
+			}
+		})
+	}
+
+	output, err := document.Find("body").Html()
+	if err != nil {
+		return content
+	}
+
+	return output
+}
+
+// contentExtractors returns the extraction strategies in order of preference:
+// the custom rules of the feed, the predefined rules of the website and the readability extractors.
+// Scraper rules are used only when the page is on the same website as the entry URL.
+func contentExtractors(websiteURL string, sameSite bool, rules string) []contentExtractor {
+	var extractors []contentExtractor
+
+	if sameSite && rules != "" {
+		extractors = append(extractors, contentExtractor{StrategyCustomRules, rulesExtractor{rules}, 0})
+	}
+
+	if predefinedRules, _ := parseScraperRules(getPredefinedScraperRules(websiteURL)); sameSite && predefinedRules != "" {
+		extractors = append(extractors, contentExtractor{StrategyPredefinedRules, rulesExtractor{predefinedRules}, 0})
 	}
 
-	return content, nil
+	return append(extractors,
+		contentExtractor{StrategyReadability, readability.ArticleExtractor{}, minReadabilityTextLength},
+		contentExtractor{StrategyLegacyReadability, readability.LegacyExtractor{}, minReadabilityTextLength},
+	)
+}
+
+// extractContent tries the content extractors until one of them returns enough content.
+func extractContent(websiteURL string, page []byte, sameSite bool, rules string) (*readability.Article, string, error) {
+	var best *readability.Article
+	var bestStrategy string
+	var bestLength int
+	var lastErr error
+
+	for _, candidate := range contentExtractors(websiteURL, sameSite, rules) {
+		article, err := candidate.extractor.Extract(websiteURL, bytes.NewReader(page))
+		if err != nil {
+			slog.Debug("Unable to extract content",
+				slog.String("website_url", websiteURL),
+				slog.String("strategy", candidate.strategy),
+				slog.Any("error", err),
+			)
+			lastErr = err
+			continue
+		}
+
+		length := textLength(article.Content)
+		if best == nil || length > bestLength {
+			best, bestStrategy, bestLength = article, candidate.strategy, length
+		}
+
+		if length >= candidate.minTextLength {
+			break
+		}
+	}
+
+	if best == nil {
+		return nil, "", lastErr
+	}
+
+	return best, bestStrategy, nil
+}
+
+func textLength(content string) int {
+	document, err := goquery.NewDocumentFromReader(strings.NewReader(content))
+	if err != nil {
+		return 0
+	}
+	return len(strings.TrimSpace(document.Text()))
 }
 
 func findContentUsingCustomRules(page io.Reader, rules string) (string, error) {
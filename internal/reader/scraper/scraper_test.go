@@ -5,9 +5,17 @@ package scraper // import "miniflux.app/v2/internal/reader/scraper"
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/reader/fetcher"
 )
 
 func TestGetPredefinedRules(t *testing.T) {
@@ -77,3 +85,265 @@ func TestSelectorRules(t *testing.T) {
 		}
 	}
 }
+
+func TestExtractContentStrategies(t *testing.T) {
+	page := []byte(`<html><body>
+		<article><p class="summary">Summary of the article.</p><div class="content"><p>` + strings.Repeat("This is a sentence of the article. ", 10) + `</p></div></article>
+	</body></html>`)
+
+	scenarios := []struct {
+		websiteURL string
+		sameSite   bool
+		rules      string
+		strategy   string
+	}{
+		{"https://example.org/article", true, "p.summary", StrategyCustomRules},
+		{"https://example.org/article", false, "p.summary", StrategyReadability},
+		{"https://example.org/article", true, ".missing", StrategyReadability},
+		{"https://www.phoronix.com/article", true, "", StrategyPredefinedRules},
+		{"https://www.phoronix.com/article", false, "", StrategyReadability},
+	}
+
+	for _, scenario := range scenarios {
+		article, strategy, err := extractContent(scenario.websiteURL, page, scenario.sameSite, scenario.rules)
+		if err != nil {
+			t.Fatalf(`Unable to extract content for %+v: %v`, scenario, err)
+		}
+
+		if strategy != scenario.strategy {
+			t.Errorf(`Unexpected strategy for %+v, got %q`, scenario, strategy)
+		}
+
+		if strategy == StrategyReadability && !strings.Contains(article.Content, "This is a sentence of the article.") {
+			t.Errorf(`Unexpected content for %+v, got %q`, scenario, article.Content)
+		}
+	}
+}
+
+func TestRulesExtractor(t *testing.T) {
+	page := `<html><body><article><p>Content</p></article></body></html>`
+
+	article, err := rulesExtractor{"article"}.Extract("https://example.org/", strings.NewReader(page))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `<article><p>Content</p></article>`; article.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, article.Content, expected)
+	}
+
+	if _, err := (rulesExtractor{"div.missing"}).Extract("https://example.org/", strings.NewReader(page)); !errors.Is(err, errNoMatchingContent) {
+		t.Errorf(`Unexpected error, got %v`, err)
+	}
+}
+
+func TestExtractContentFallbackToLegacyReadability(t *testing.T) {
+	// Some websites put the whole page in a form, which is removed by the new readability engine.
+	page := []byte(`<html><body><form id="aspnetForm"><div><p>` + strings.Repeat("A sentence of the article. ", 10) + `</p></div></form></body></html>`)
+
+	article, strategy, err := extractContent("https://example.org/", page, true, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strategy != StrategyLegacyReadability {
+		t.Errorf(`Unexpected strategy, got %q`, strategy)
+	}
+
+	if !strings.Contains(article.Content, "A sentence of the article.") {
+		t.Errorf(`Unexpected content, got %q`, article.Content)
+	}
+}
+
+func TestParseScraperRules(t *testing.T) {
+	scenarios := []struct {
+		rules         string
+		contentRules  string
+		nextPageRules string
+	}{
+		{"", "", ""},
+		{"article > p", "article > p", ""},
+		{"article > p, article > img", "article > p, article > img", ""},
+		{"div.content; next_page: a.next", "div.content", "a.next"},
+		{"div.content ;next_page:a.next, a.more", "div.content", "a.next, a.more"},
+		{"; next_page: a.next", "", "a.next"},
+		{"div.content; unknown: a", "div.content", ""},
+	}
+
+	for _, scenario := range scenarios {
+		contentRules, nextPageRules := parseScraperRules(scenario.rules)
+		if contentRules != scenario.contentRules || nextPageRules != scenario.nextPageRules {
+			t.Errorf(`Unexpected result for %q, got %q and %q`, scenario.rules, contentRules, nextPageRules)
+		}
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	scenarios := map[string]bool{
+		"":                                   true,
+		"div.content":                        true,
+		"div.content; next_page: a.next":     true,
+		"div.content; next_page: a[rel=":     false,
+		"div[":                               false,
+		"div.content; unknown_directive: ((": true,
+	}
+
+	for rules, expected := range scenarios {
+		if result := ValidateRules(rules) == nil; result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, rules, result, expected)
+		}
+	}
+}
+
+func TestFindNextPageURL(t *testing.T) {
+	scenarios := []struct {
+		page          string
+		nextPageRules string
+		expected      string
+	}{
+		{`<html><head><link rel="next" href="/article/2"></head></html>`, "", "https://example.org/article/2"},
+		{`<p><a rel="nofollow next" href="?page=2">Next</a></p>`, "", ""},
+		{`<p><a rel="nofollow next" href="?page=2">Next</a></p>`, `a[rel~="next"]`, "https://example.org/article/1?page=2"},
+		{`<p><a class="next" href="/article/2">Next</a></p>`, "", ""},
+		{`<p><a class="next" href="/article/2">Next</a></p>`, "a.next", "https://example.org/article/2"},
+		{`<p><a rel="next" href="#comments">Next</a></p>`, "", ""},
+		{`<p><a rel="next" href="javascript:void(0)">Next</a></p>`, "", ""},
+	}
+
+	for _, scenario := range scenarios {
+		result := findNextPageURL("https://example.org/article/1", []byte(scenario.page), scenario.nextPageRules)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected next page URL for %q, got %q instead of %q`, scenario.page, result, scenario.expected)
+		}
+	}
+}
+
+func TestScrapeMultiPageArticle(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPages = append(requestedPages, r.URL.String())
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+		switch r.URL.Path {
+		case "/article":
+			fmt.Fprint(w, `<html><head><link rel="next" href="/article/page/2"></head><body><article><p>First page.</p></article></body></html>`)
+		case "/article/page/2":
+			fmt.Fprint(w, `<html><body><article><p>Second page.</p><img src="image.png"></article><a class="next" href="/article/page/3">Next</a></body></html>`)
+		case "/article/page/3":
+			fmt.Fprint(w, `<html><body><article><p>Third page.</p></article><a class="next" href="https://other.example.org/article/page/4">Next</a></body></html>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	article, err := ScrapeWebsite(fetcher.NewRequestBuilder(), server.URL+"/article", "article; next_page: link[rel=next], a.next")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `<article><p>First page.</p></article>` +
+		`<article><p>Second page.</p><img src="` + server.URL + `/article/page/image.png"/></article>` +
+		`<article><p>Third page.</p></article>`
+	if article.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, article.Content, expected)
+	}
+
+	if len(requestedPages) != 3 {
+		t.Errorf(`Unexpected requested pages: %v`, requestedPages)
+	}
+}
+
+func TestScrapeMultiPageArticleWithPageLimit(t *testing.T) {
+	os.Clearenv()
+	os.Setenv("SCRAPER_MAX_PAGES", "2")
+
+	var err error
+	parser := config.NewParser()
+	config.Opts, err = parser.ParseEnvironmentVariables()
+	if err != nil {
+		t.Fatalf(`Parsing failure: %v`, err)
+	}
+
+	requestCount := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestCount++
+		w.Header().Set("Content-Type", "text/html")
+
+		// Every page links to the next one.
+		fmt.Fprintf(w, `<html><head><link rel="next" href="/article?page=%d"></head><body><article><p>Page %d.</p></article></body></html>`, requestCount+1, requestCount)
+	}))
+	defer server.Close()
+
+	article, err := ScrapeWebsite(fetcher.NewRequestBuilder(), server.URL+"/article", "article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requestCount != 2 {
+		t.Errorf(`Unexpected number of requests, got %d`, requestCount)
+	}
+
+	expected := `<article><p>Page 1.</p></article><article><p>Page 2.</p></article>`
+	if article.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, article.Content, expected)
+	}
+}
+
+func TestScrapeBlogPostWithNextPostLink(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	var requestedPages []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPages = append(requestedPages, r.URL.Path)
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><body><article><p>Blog post.</p></article><nav><a rel="next" href="/next-post">Next post</a></nav></body></html>`)
+	}))
+	defer server.Close()
+
+	article, err := ScrapeWebsite(fetcher.NewRequestBuilder(), server.URL+"/post", "article")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if expected := `<article><p>Blog post.</p></article>`; article.Content != expected {
+		t.Errorf(`Unexpected content, got %q instead of %q`, article.Content, expected)
+	}
+
+	if len(requestedPages) != 1 {
+		t.Errorf(`The link to the next post should not be followed, requested pages: %v`, requestedPages)
+	}
+}
+
+func TestScrapeWebsiteReturnsArticleMetadata(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, `<html><head>
+			<meta property="og:title" content="Article Title">
+			<meta name="author" content="Jane Doe">
+			<meta property="article:published_time" content="2024-03-01T10:00:00Z">
+		</head><body><article><p>`+strings.Repeat("This is a sentence of the article. ", 10)+`</p></article></body></html>`)
+	}))
+	defer server.Close()
+
+	article, err := ScrapeWebsite(fetcher.NewRequestBuilder(), server.URL+"/article", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if article.Title != "Article Title" || article.Byline != "Jane Doe" {
+		t.Errorf(`Unexpected metadata, got %q and %q`, article.Title, article.Byline)
+	}
+
+	if expected := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC); !article.PublishedAt.Equal(expected) {
+		t.Errorf(`Unexpected publication date, got %v`, article.PublishedAt)
+	}
+
+	if !strings.Contains(article.Content, "This is a sentence of the article.") {
+		t.Errorf(`Unexpected content, got %q`, article.Content)
+	}
+}
@@ -9,12 +9,14 @@ import (
 	"io"
 	"log/slog"
 	"regexp"
+	"strings"
 
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/integration/rssbridge"
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/reader/fetcher"
+	"miniflux.app/v2/internal/reader/hfeed"
 	"miniflux.app/v2/internal/reader/parser"
 	"miniflux.app/v2/internal/urllib"
 
@@ -70,7 +72,8 @@ func (f *SubscriptionFinder) FindSubscriptions(websiteURL, rssBridgeURL string)
 	}
 
 	// Step 1) Check if the website URL is a feed.
-	if feedFormat, _ := parser.DetectFeedFormat(f.feedResponseInfo.Content); feedFormat != parser.FormatUnknown {
+	// Web pages carrying h-feed microformats are handled in step 5, they may also advertise other feeds.
+	if feedFormat, _ := parser.DetectFeedFormat(f.feedResponseInfo.Content); feedFormat != parser.FormatUnknown && feedFormat != parser.FormatHFeed {
 		f.feedDownloaded = true
 		return Subscriptions{NewSubscription(responseHandler.EffectiveURL(), responseHandler.EffectiveURL(), feedFormat)}, nil
 	}
@@ -161,6 +164,7 @@ func (f *SubscriptionFinder) FindSubscriptionsFromWebPage(websiteURL, contentTyp
 		"link[type='application/atom+xml']":  parser.FormatAtom,
 		"link[type='application/json']":      parser.FormatJSON,
 		"link[type='application/feed+json']": parser.FormatJSON,
+		"link[type='text/mf2+html']":         parser.FormatHFeed,
 	}
 
 	htmlDocumentReader, err := charset.NewReader(body, contentType)
@@ -204,6 +208,15 @@ func (f *SubscriptionFinder) FindSubscriptionsFromWebPage(websiteURL, contentTyp
 		})
 	}
 
+	// The web page itself is the feed when it carries h-feed microformats and doesn't advertise any other feed.
+	if len(subscriptions) == 0 && hfeed.ContainsFeed(doc) {
+		title := strings.TrimSpace(doc.Find("head title").First().Text())
+		if title == "" {
+			title = websiteURL
+		}
+		subscriptions = append(subscriptions, NewSubscription(title, websiteURL, parser.FormatHFeed))
+	}
+
 	return subscriptions, nil
 }
 
@@ -7,6 +7,7 @@ import (
 	"database/sql"
 	"fmt"
 	"strings"
+	"time"
 
 	"miniflux.app/v2/internal/model"
 )
@@ -16,6 +17,7 @@ type BatchBuilder struct {
 	args       []any
 	conditions []string
 	limit      int
+	lease      time.Duration
 }
 
 func (s *Storage) NewBatchBuilder() *BatchBuilder {
@@ -59,8 +61,21 @@ func (b *BatchBuilder) WithoutDisabledFeeds() *BatchBuilder {
 	return b
 }
 
+// WithLease reserves the selected feeds for the given duration: schedulers of other instances
+// sharing the same database skip them until the lease expires.
+func (b *BatchBuilder) WithLease(lease time.Duration) *BatchBuilder {
+	b.conditions = append(b.conditions, "(leased_until IS NULL OR leased_until < now())")
+	b.lease = lease
+	return b
+}
+
 func (b *BatchBuilder) FetchJobs() (jobs model.JobList, err error) {
-	query := `SELECT id, user_id FROM feeds`
+	columns := "id, user_id"
+	if b.lease > 0 {
+		columns = "id"
+	}
+
+	query := fmt.Sprintf(`SELECT %s FROM feeds`, columns)
 
 	if len(b.conditions) > 0 {
 		query += fmt.Sprintf(" WHERE %s", strings.Join(b.conditions, " AND "))
@@ -70,6 +85,21 @@ func (b *BatchBuilder) FetchJobs() (jobs model.JobList, err error) {
 		query += fmt.Sprintf(" ORDER BY next_check_at ASC LIMIT %d", b.limit)
 	}
 
+	if b.lease > 0 {
+		// Rows locked by a concurrent scheduler are skipped instead of being selected twice.
+		query = fmt.Sprintf(`
+			UPDATE
+				feeds
+			SET
+				leased_until = now() + $%d * interval '1 second'
+			WHERE
+				id IN (%s FOR UPDATE SKIP LOCKED)
+			RETURNING
+				id, user_id
+		`, len(b.args)+1, query)
+		b.args = append(b.args, int64(b.lease.Seconds()))
+	}
+
 	rows, err := b.db.Query(query, b.args...)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch batch of jobs: %v`, err)
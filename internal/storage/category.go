@@ -40,8 +40,8 @@ func (s *Storage) CategoryIDExists(userID, categoryID int64) bool {
 func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND id=$2`
-	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT id, user_id, title, hide_globally, blocklist_rules, keeplist_rules FROM categories WHERE user_id=$1 AND id=$2`
+	err := s.db.QueryRow(query, userID, categoryID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.BlocklistRules, &category.KeeplistRules)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -55,10 +55,10 @@ func (s *Storage) Category(userID, categoryID int64) (*model.Category, error) {
 
 // FirstCategory returns the first category for the given user.
 func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
+	query := `SELECT id, user_id, title, hide_globally, blocklist_rules, keeplist_rules FROM categories WHERE user_id=$1 ORDER BY title ASC LIMIT 1`
 
 	var category model.Category
-	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	err := s.db.QueryRow(query, userID).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.BlocklistRules, &category.KeeplistRules)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -74,8 +74,8 @@ func (s *Storage) FirstCategory(userID int64) (*model.Category, error) {
 func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category, error) {
 	var category model.Category
 
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 AND title=$2`
-	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally)
+	query := `SELECT id, user_id, title, hide_globally, blocklist_rules, keeplist_rules FROM categories WHERE user_id=$1 AND title=$2`
+	err := s.db.QueryRow(query, userID, title).Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.BlocklistRules, &category.KeeplistRules)
 
 	switch {
 	case err == sql.ErrNoRows:
@@ -89,7 +89,7 @@ func (s *Storage) CategoryByTitle(userID int64, title string) (*model.Category,
 
 // Categories returns all categories that belongs to the given user.
 func (s *Storage) Categories(userID int64) (model.Categories, error) {
-	query := `SELECT id, user_id, title, hide_globally FROM categories WHERE user_id=$1 ORDER BY title ASC`
+	query := `SELECT id, user_id, title, hide_globally, blocklist_rules, keeplist_rules FROM categories WHERE user_id=$1 ORDER BY title ASC`
 	rows, err := s.db.Query(query, userID)
 	if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch categories: %v`, err)
@@ -99,7 +99,7 @@ func (s *Storage) Categories(userID int64) (model.Categories, error) {
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.BlocklistRules, &category.KeeplistRules); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -122,6 +122,8 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 			c.user_id,
 			c.title,
 			c.hide_globally,
+			c.blocklist_rules,
+			c.keeplist_rules,
 			(SELECT count(*) FROM feeds WHERE feeds.category_id=c.id) AS count,
 			(SELECT count(*)
 			   FROM feeds
@@ -154,7 +156,7 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 	categories := make(model.Categories, 0)
 	for rows.Next() {
 		var category model.Category
-		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.FeedCount, &category.TotalUnread); err != nil {
+		if err := rows.Scan(&category.ID, &category.UserID, &category.Title, &category.HideGlobally, &category.BlocklistRules, &category.KeeplistRules, &category.FeedCount, &category.TotalUnread); err != nil {
 			return nil, fmt.Errorf(`store: unable to fetch category row: %v`, err)
 		}
 
@@ -168,24 +170,38 @@ func (s *Storage) CategoriesWithFeedCount(userID int64) (model.Categories, error
 func (s *Storage) CreateCategory(userID int64, request *model.CategoryRequest) (*model.Category, error) {
 	var category model.Category
 
+	var blocklistRules, keeplistRules string
+	if request.BlocklistRules != nil {
+		blocklistRules = *request.BlocklistRules
+	}
+	if request.KeeplistRules != nil {
+		keeplistRules = *request.KeeplistRules
+	}
+
 	query := `
 		INSERT INTO categories
-			(user_id, title)
+			(user_id, title, blocklist_rules, keeplist_rules)
 		VALUES
-			($1, $2)
+			($1, $2, $3, $4)
 		RETURNING
 			id,
 			user_id,
-			title
+			title,
+			blocklist_rules,
+			keeplist_rules
 	`
 	err := s.db.QueryRow(
 		query,
 		userID,
 		request.Title,
+		blocklistRules,
+		keeplistRules,
 	).Scan(
 		&category.ID,
 		&category.UserID,
 		&category.Title,
+		&category.BlocklistRules,
+		&category.KeeplistRules,
 	)
 
 	if err != nil {
@@ -197,11 +213,13 @@ func (s *Storage) CreateCategory(userID int64, request *model.CategoryRequest) (
 
 // UpdateCategory updates an existing category.
 func (s *Storage) UpdateCategory(category *model.Category) error {
-	query := `UPDATE categories SET title=$1, hide_globally = $2 WHERE id=$3 AND user_id=$4`
+	query := `UPDATE categories SET title=$1, hide_globally = $2, blocklist_rules = $3, keeplist_rules = $4 WHERE id=$5 AND user_id=$6`
 	_, err := s.db.Exec(
 		query,
 		category.Title,
 		category.HideGlobally,
+		category.BlocklistRules,
+		category.KeeplistRules,
 		category.ID,
 		category.UserID,
 	)
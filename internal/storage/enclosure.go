@@ -23,7 +23,13 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			url,
 			size,
 			mime_type,
-		    media_progression
+			media_progression,
+			duration,
+			width,
+			height,
+			thumbnail_url,
+			title,
+			played
 		FROM
 			enclosures
 		WHERE
@@ -48,6 +54,12 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 			&enclosure.Size,
 			&enclosure.MimeType,
 			&enclosure.MediaProgression,
+			&enclosure.Duration,
+			&enclosure.Width,
+			&enclosure.Height,
+			&enclosure.ThumbnailURL,
+			&enclosure.Title,
+			&enclosure.Played,
 		)
 
 		if err != nil {
@@ -60,6 +72,7 @@ func (s *Storage) GetEnclosures(entryID int64) (model.EnclosureList, error) {
 	return enclosures, nil
 }
 
+// GetEnclosure returns the attachment with the given ID, or nil when it doesn't exist.
 func (s *Storage) GetEnclosure(enclosureID int64) (*model.Enclosure, error) {
 	query := `
 		SELECT
@@ -69,7 +82,13 @@ func (s *Storage) GetEnclosure(enclosureID int64) (*model.Enclosure, error) {
 			url,
 			size,
 			mime_type,
-		    media_progression
+			media_progression,
+			duration,
+			width,
+			height,
+			thumbnail_url,
+			title,
+			played
 		FROM
 			enclosures
 		WHERE
@@ -88,9 +107,17 @@ func (s *Storage) GetEnclosure(enclosureID int64) (*model.Enclosure, error) {
 		&enclosure.Size,
 		&enclosure.MimeType,
 		&enclosure.MediaProgression,
+		&enclosure.Duration,
+		&enclosure.Width,
+		&enclosure.Height,
+		&enclosure.ThumbnailURL,
+		&enclosure.Title,
+		&enclosure.Played,
 	)
 
-	if err != nil {
+	if err == sql.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
 		return nil, fmt.Errorf(`store: unable to fetch enclosure row: %v`, err)
 	}
 
@@ -105,10 +132,15 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 
 	query := `
 		INSERT INTO enclosures
-			(url, size, mime_type, entry_id, user_id, media_progression)
+			(url, size, mime_type, entry_id, user_id, media_progression, duration, width, height, thumbnail_url, title)
 		VALUES
-			($1, $2, $3, $4, $5, $6)
-		ON CONFLICT (user_id, entry_id, md5(url)) DO NOTHING
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		ON CONFLICT (user_id, entry_id, md5(url)) DO UPDATE SET
+			duration=EXCLUDED.duration,
+			width=EXCLUDED.width,
+			height=EXCLUDED.height,
+			thumbnail_url=EXCLUDED.thumbnail_url,
+			title=EXCLUDED.title
 		RETURNING
 			id
 	`
@@ -120,6 +152,11 @@ func (s *Storage) createEnclosure(tx *sql.Tx, enclosure *model.Enclosure) error
 		enclosure.EntryID,
 		enclosure.UserID,
 		enclosure.MediaProgression,
+		enclosure.Duration,
+		enclosure.Width,
+		enclosure.Height,
+		enclosure.ThumbnailURL,
+		enclosure.Title,
 	).Scan(&enclosure.ID); err != nil && err != sql.ErrNoRows {
 		return fmt.Errorf(`store: unable to create enclosure: %w`, err)
 	}
@@ -166,9 +203,15 @@ func (s *Storage) UpdateEnclosure(enclosure *model.Enclosure) error {
 			mime_type=$3,
 			entry_id=$4, 
 			user_id=$5, 
-			media_progression=$6
+			media_progression=$6,
+			duration=$7,
+			width=$8,
+			height=$9,
+			thumbnail_url=$10,
+			title=$11,
+			played=$12
 		WHERE
-			id=$7
+			id=$13
 	`
 	_, err := s.db.Exec(query,
 		enclosure.URL,
@@ -177,6 +220,12 @@ func (s *Storage) UpdateEnclosure(enclosure *model.Enclosure) error {
 		enclosure.EntryID,
 		enclosure.UserID,
 		enclosure.MediaProgression,
+		enclosure.Duration,
+		enclosure.Width,
+		enclosure.Height,
+		enclosure.ThumbnailURL,
+		enclosure.Title,
+		enclosure.Played,
 		enclosure.ID,
 	)
 
@@ -186,3 +235,47 @@ func (s *Storage) UpdateEnclosure(enclosure *model.Enclosure) error {
 
 	return nil
 }
+
+// UpdateEnclosureProgression saves the playback position of an enclosure, in seconds.
+func (s *Storage) UpdateEnclosureProgression(userID, enclosureID, progression int64) error {
+	query := `
+		UPDATE
+			enclosures
+		SET
+			media_progression=$1,
+			media_progression_updated_at=now()
+		WHERE
+			user_id=$2 AND id=$3
+	`
+	if _, err := s.db.Exec(query, progression, userID, enclosureID); err != nil {
+		return fmt.Errorf(`store: unable to update progression of enclosure #%d: %v`, enclosureID, err)
+	}
+
+	return nil
+}
+
+// SetEnclosurePlayed changes the played state of an enclosure, played enclosures are removed from the listening queue.
+func (s *Storage) SetEnclosurePlayed(userID, enclosureID int64, played bool) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	if _, err := tx.Exec(`UPDATE enclosures SET played=$1 WHERE user_id=$2 AND id=$3`, played, userID, enclosureID); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to update played state of enclosure #%d: %v`, enclosureID, err)
+	}
+
+	if played {
+		if _, err := tx.Exec(`DELETE FROM listening_queue WHERE user_id=$1 AND enclosure_id=$2`, userID, enclosureID); err != nil {
+			tx.Rollback()
+			return fmt.Errorf(`store: unable to remove enclosure #%d from the listening queue: %v`, enclosureID, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
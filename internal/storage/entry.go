@@ -107,7 +107,9 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				reading_time,
 				changed_at,
 				document_vectors,
-				tags
+				tags,
+				canonical_url,
+				podcast
 			)
 		VALUES
 			(
@@ -123,7 +125,9 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 				$10,
 				now(),
 				setweight(to_tsvector(left(coalesce($1, ''), 500000)), 'A') || setweight(to_tsvector(left(coalesce($6, ''), 500000)), 'B'),
-				$11
+				$11,
+				$12,
+				$13
 			)
 		RETURNING
 			id, status, created_at, changed_at
@@ -141,6 +145,8 @@ func (s *Storage) createEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.FeedID,
 		entry.ReadingTime,
 		pq.Array(removeEmpty(removeDuplicates(entry.Tags))),
+		entry.CanonicalURL,
+		entry.Podcast,
 	).Scan(
 		&entry.ID,
 		&entry.Status,
@@ -179,7 +185,9 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 			author=$5,
 			reading_time=$6,
 			document_vectors = setweight(to_tsvector(left(coalesce($1, ''), 500000)), 'A') || setweight(to_tsvector(left(coalesce($4, ''), 500000)), 'B'),
-			tags=$10
+			tags=$10,
+			canonical_url=$11,
+			podcast=$12
 		WHERE
 			user_id=$7 AND feed_id=$8 AND hash=$9
 		RETURNING
@@ -197,6 +205,8 @@ func (s *Storage) updateEntry(tx *sql.Tx, entry *model.Entry) error {
 		entry.FeedID,
 		entry.Hash,
 		pq.Array(removeEmpty(removeDuplicates(entry.Tags))),
+		entry.CanonicalURL,
+		entry.Podcast,
 	).Scan(&entry.ID)
 
 	if err != nil {
@@ -464,6 +474,107 @@ func (s *Storage) SetEntriesBookmarkedState(userID int64, entryIDs []int64, star
 	return nil
 }
 
+// DuplicateEntryByCanonicalURL returns the oldest entry from another feed having the same canonical URL as the given entry.
+func (s *Storage) DuplicateEntryByCanonicalURL(entry *model.Entry) (*model.Entry, error) {
+	if entry.CanonicalURL == "" {
+		return nil, nil
+	}
+
+	query := `
+		SELECT
+			id,
+			feed_id,
+			title,
+			canonical_url
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND
+			canonical_url <> '' AND
+			canonical_url=$2 AND
+			feed_id <> $3 AND
+			id < $4 AND
+			duplicate_of_id IS NULL AND
+			status <> 'removed'
+		ORDER BY id ASC
+		LIMIT 1
+	`
+
+	original := &model.Entry{UserID: entry.UserID}
+	err := s.db.QueryRow(query, entry.UserID, entry.CanonicalURL, entry.FeedID, entry.ID).Scan(
+		&original.ID,
+		&original.FeedID,
+		&original.Title,
+		&original.CanonicalURL,
+	)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to find duplicate of entry #%d by canonical URL: %v`, entry.ID, err)
+	}
+
+	return original, nil
+}
+
+// DuplicateEntryCandidates returns the entries from other feeds published around the same date as the given entry,
+// their titles are compared when no entry has the same canonical URL.
+func (s *Storage) DuplicateEntryCandidates(entry *model.Entry, window time.Duration) (model.Entries, error) {
+	query := `
+		SELECT
+			id,
+			feed_id,
+			title,
+			canonical_url
+		FROM
+			entries
+		WHERE
+			user_id=$1 AND
+			feed_id <> $2 AND
+			id < $3 AND
+			duplicate_of_id IS NULL AND
+			status <> 'removed' AND
+			published_at BETWEEN $4 AND $5
+		ORDER BY id ASC
+		LIMIT 500
+	`
+
+	rows, err := s.db.Query(
+		query,
+		entry.UserID,
+		entry.FeedID,
+		entry.ID,
+		entry.Date.Add(-window),
+		entry.Date.Add(window),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch duplicate candidates for entry #%d: %v`, entry.ID, err)
+	}
+	defer rows.Close()
+
+	var candidates model.Entries
+	for rows.Next() {
+		candidate := &model.Entry{UserID: entry.UserID}
+		if err := rows.Scan(&candidate.ID, &candidate.FeedID, &candidate.Title, &candidate.CanonicalURL); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch duplicate candidate: %v`, err)
+		}
+		candidates = append(candidates, candidate)
+	}
+
+	return candidates, nil
+}
+
+// MarkEntryAsDuplicate links the entry to the first-seen entry and marks it as read.
+func (s *Storage) MarkEntryAsDuplicate(userID, entryID, duplicateOfID int64) error {
+	query := `UPDATE entries SET duplicate_of_id=$1, status=$2, changed_at=now(), read_at=now() WHERE user_id=$3 AND id=$4`
+	if _, err := s.db.Exec(query, duplicateOfID, model.EntryStatusRead, userID, entryID); err != nil {
+		return fmt.Errorf(`store: unable to mark entry #%d as duplicate of entry #%d: %v`, entryID, duplicateOfID, err)
+	}
+
+	return nil
+}
+
 // ToggleBookmark toggles entry bookmark value.
 func (s *Storage) ToggleBookmark(userID int64, entryID int64) error {
 	query := `UPDATE entries SET starred = NOT starred, changed_at=now() WHERE user_id=$1 AND id=$2`
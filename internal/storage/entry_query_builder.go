@@ -277,6 +277,8 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			e.created_at,
 			e.changed_at,
 			e.tags,
+			e.podcast,
+			coalesce(e.duplicate_of_id, 0),
 			(SELECT true FROM enclosures WHERE entry_id=e.id LIMIT 1) as has_enclosure,
 			f.title as feed_title,
 			f.feed_url,
@@ -343,6 +345,8 @@ func (e *EntryQueryBuilder) GetEntries() (model.Entries, error) {
 			&entry.CreatedAt,
 			&entry.ChangedAt,
 			pq.Array(&entry.Tags),
+			&entry.Podcast,
+			&entry.DuplicateOfID,
 			&hasEnclosure,
 			&entry.Feed.Title,
 			&entry.Feed.FeedURL,
@@ -153,6 +153,14 @@ func (s *Storage) FetchCounters(userID int64) (model.FeedCounters, error) {
 	return model.FeedCounters{ReadCounters: reads, UnreadCounters: unreads}, err
 }
 
+// FeedsByCategory returns all feeds of the given user/category.
+func (s *Storage) FeedsByCategory(userID, categoryID int64) (model.Feeds, error) {
+	builder := NewFeedQueryBuilder(s, userID)
+	builder.WithCategoryID(categoryID)
+	builder.WithSorting(model.DefaultFeedSorting, model.DefaultFeedSortingDirection)
+	return builder.GetFeeds()
+}
+
 // FeedsByCategoryWithCounters returns all feeds of the given user/category with counters of read and unread entries.
 func (s *Storage) FeedsByCategoryWithCounters(userID, categoryID int64) (model.Feeds, error) {
 	builder := NewFeedQueryBuilder(s, userID)
@@ -239,10 +247,15 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 			url_rewrite_rules,
 			no_media_player,
 			apprise_service_urls,
-			disable_http2
+			disable_http2,
+			page_item_selector,
+			page_title_selector,
+			page_link_selector,
+			page_date_selector,
+			page_content_selector
 		)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)
 		RETURNING
 			id
 	`
@@ -274,6 +287,11 @@ func (s *Storage) CreateFeed(feed *model.Feed) error {
 		feed.NoMediaPlayer,
 		feed.AppriseServiceURLs,
 		feed.DisableHTTP2,
+		feed.PageSelectors.Item,
+		feed.PageSelectors.Title,
+		feed.PageSelectors.Link,
+		feed.PageSelectors.Date,
+		feed.PageSelectors.Content,
 	).Scan(&feed.ID)
 	if err != nil {
 		return fmt.Errorf(`store: unable to create feed %q: %v`, feed.FeedURL, err)
@@ -347,9 +365,16 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 			url_rewrite_rules=$26,
 			no_media_player=$27,
 			apprise_service_urls=$28,
-			disable_http2=$29
+			disable_http2=$29,
+			permanent_redirect_url=$30,
+			permanent_redirect_count=$31,
+			page_item_selector=$32,
+			page_title_selector=$33,
+			page_link_selector=$34,
+			page_date_selector=$35,
+			page_content_selector=$36
 		WHERE
-			id=$30 AND user_id=$31
+			id=$37 AND user_id=$38
 	`
 	_, err = s.db.Exec(query,
 		feed.FeedURL,
@@ -381,6 +406,13 @@ func (s *Storage) UpdateFeed(feed *model.Feed) (err error) {
 		feed.NoMediaPlayer,
 		feed.AppriseServiceURLs,
 		feed.DisableHTTP2,
+		feed.PermanentRedirectURL,
+		feed.PermanentRedirectCount,
+		feed.PageSelectors.Item,
+		feed.PageSelectors.Title,
+		feed.PageSelectors.Link,
+		feed.PageSelectors.Date,
+		feed.PageSelectors.Content,
 		feed.ID,
 		feed.UserID,
 	)
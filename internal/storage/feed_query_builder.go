@@ -162,10 +162,19 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			f.category_id,
 			c.title as category_title,
 			c.hide_globally as category_hidden,
+			c.blocklist_rules as category_blocklist_rules,
+			c.keeplist_rules as category_keeplist_rules,
 			fi.icon_id,
 			u.timezone,
 			f.apprise_service_urls,
-			f.disable_http2
+			f.disable_http2,
+			f.permanent_redirect_url,
+			f.permanent_redirect_count,
+			f.page_item_selector,
+			f.page_title_selector,
+			f.page_link_selector,
+			f.page_date_selector,
+			f.page_content_selector
 		FROM
 			feeds f
 		LEFT JOIN
@@ -230,10 +239,19 @@ func (f *FeedQueryBuilder) GetFeeds() (model.Feeds, error) {
 			&feed.Category.ID,
 			&feed.Category.Title,
 			&feed.Category.HideGlobally,
+			&feed.Category.BlocklistRules,
+			&feed.Category.KeeplistRules,
 			&iconID,
 			&tz,
 			&feed.AppriseServiceURLs,
 			&feed.DisableHTTP2,
+			&feed.PermanentRedirectURL,
+			&feed.PermanentRedirectCount,
+			&feed.PageSelectors.Item,
+			&feed.PageSelectors.Title,
+			&feed.PageSelectors.Link,
+			&feed.PageSelectors.Date,
+			&feed.PageSelectors.Content,
 		)
 
 		if err != nil {
@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// feedRefreshHistorySize is the number of refresh attempts kept for each feed.
+const feedRefreshHistorySize = 50
+
+// CreateFeedRefresh records a refresh attempt and removes the oldest attempts of the feed.
+func (s *Storage) CreateFeedRefresh(refresh *model.FeedRefresh) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	query := `
+		INSERT INTO feed_refreshes
+			(user_id, feed_id, checked_at, status_code, duration, size, new_entries, error_msg, new_feed_url)
+		VALUES
+			($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		RETURNING
+			id
+	`
+	err = tx.QueryRow(
+		query,
+		refresh.UserID,
+		refresh.FeedID,
+		refresh.CheckedAt,
+		refresh.StatusCode,
+		refresh.Duration,
+		refresh.Size,
+		refresh.NewEntries,
+		refresh.Error,
+		refresh.NewFeedURL,
+	).Scan(&refresh.ID)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to create feed refresh for feed #%d: %v`, refresh.FeedID, err)
+	}
+
+	query = `
+		DELETE FROM
+			feed_refreshes
+		WHERE
+			feed_id=$1 AND id NOT IN (
+				SELECT id FROM feed_refreshes WHERE feed_id=$1 ORDER BY checked_at DESC, id DESC LIMIT $2
+			)
+	`
+	if _, err := tx.Exec(query, refresh.FeedID, feedRefreshHistorySize); err != nil {
+		tx.Rollback()
+		return fmt.Errorf(`store: unable to remove old refreshes of feed #%d: %v`, refresh.FeedID, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+// FeedRefreshes returns the recent refresh attempts of a feed, the most recent first.
+func (s *Storage) FeedRefreshes(userID, feedID int64) (model.FeedRefreshes, error) {
+	query := `
+		SELECT
+			id, user_id, feed_id, checked_at, status_code, duration, size, new_entries, error_msg, new_feed_url
+		FROM
+			feed_refreshes
+		WHERE
+			user_id=$1 AND feed_id=$2
+		ORDER BY
+			checked_at DESC, id DESC
+	`
+	rows, err := s.db.Query(query, userID, feedID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch refreshes of feed #%d: %v`, feedID, err)
+	}
+	defer rows.Close()
+
+	refreshes := make(model.FeedRefreshes, 0)
+	for rows.Next() {
+		var refresh model.FeedRefresh
+		err := rows.Scan(
+			&refresh.ID,
+			&refresh.UserID,
+			&refresh.FeedID,
+			&refresh.CheckedAt,
+			&refresh.StatusCode,
+			&refresh.Duration,
+			&refresh.Size,
+			&refresh.NewEntries,
+			&refresh.Error,
+			&refresh.NewFeedURL,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch feed refresh row: %v`, err)
+		}
+		refreshes = append(refreshes, &refresh)
+	}
+
+	return refreshes, nil
+}
+
+// FlakyFeeds returns the feeds of a user with failed refresh attempts in their history, the least reliable first.
+func (s *Storage) FlakyFeeds(userID int64) (model.FeedHealthList, error) {
+	query := `
+		SELECT
+			f.id,
+			f.title,
+			count(*),
+			count(*) FILTER (WHERE r.error_msg <> ''),
+			coalesce(avg(r.duration), 0)::bigint,
+			max(r.checked_at) FILTER (WHERE r.error_msg <> ''),
+			(
+				SELECT error_msg FROM feed_refreshes WHERE feed_id=f.id AND error_msg <> '' ORDER BY checked_at DESC, id DESC LIMIT 1
+			)
+		FROM
+			feed_refreshes r
+		JOIN
+			feeds f ON f.id=r.feed_id
+		WHERE
+			r.user_id=$1
+		GROUP BY
+			f.id, f.title
+		HAVING
+			count(*) FILTER (WHERE r.error_msg <> '') > 0
+		ORDER BY
+			count(*) FILTER (WHERE r.error_msg <> '')::float / count(*) DESC, lower(f.title) ASC
+	`
+	rows, err := s.db.Query(query, userID)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch flaky feeds: %v`, err)
+	}
+	defer rows.Close()
+
+	feeds := make(model.FeedHealthList, 0)
+	for rows.Next() {
+		var health model.FeedHealth
+		err := rows.Scan(
+			&health.FeedID,
+			&health.FeedTitle,
+			&health.Refreshes,
+			&health.Failures,
+			&health.AverageDuration,
+			&health.LastFailureAt,
+			&health.LastError,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch flaky feed row: %v`, err)
+		}
+		feeds = append(feeds, &health)
+	}
+
+	return feeds, nil
+}
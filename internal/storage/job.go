@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
+)
+
+// EnqueueJobs adds the jobs to the persistent queue and returns the number of queued jobs.
+// Feeds that already have a pending job are skipped.
+func (s *Storage) EnqueueJobs(jobs model.JobList) (int64, error) {
+	if len(jobs) == 0 {
+		return 0, nil
+	}
+
+	userIDs := make([]int64, 0, len(jobs))
+	feedIDs := make([]int64, 0, len(jobs))
+	for _, job := range jobs {
+		userIDs = append(userIDs, job.UserID)
+		feedIDs = append(feedIDs, job.FeedID)
+	}
+
+	query := `
+		INSERT INTO jobs
+			(user_id, feed_id)
+		SELECT
+			*
+		FROM
+			unnest($1::int[], $2::bigint[])
+		ON CONFLICT (feed_id) DO NOTHING
+	`
+	result, err := s.db.Exec(query, pq.Array(userIDs), pq.Array(feedIDs))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to enqueue jobs: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
+
+// EnqueuePushedContent adds a job processing the feed content pushed by a WebSub hub.
+// When the feed already has a queued job, it fetches the whole feed instead, so the content of several pushes is never lost.
+func (s *Storage) EnqueuePushedContent(userID, feedID int64, payload []byte) error {
+	query := `
+		INSERT INTO jobs
+			(user_id, feed_id, payload)
+		VALUES
+			($1, $2, $3)
+		ON CONFLICT (feed_id) DO UPDATE SET
+			payload=null
+		WHERE
+			jobs.status=$4
+	`
+	if _, err := s.db.Exec(query, userID, feedID, payload, model.JobStatusQueued); err != nil {
+		return fmt.Errorf(`store: unable to enqueue pushed content for feed #%d: %v`, feedID, err)
+	}
+	return nil
+}
+
+// ClaimJob marks the next available job as running and returns it, or nil if the queue is empty.
+// Concurrent workers, including workers of other instances, never receive the same job.
+func (s *Storage) ClaimJob() (*model.Job, error) {
+	query := `
+		UPDATE
+			jobs
+		SET
+			status=$1,
+			attempts=attempts + 1,
+			started_at=now()
+		WHERE
+			id = (
+				SELECT
+					id
+				FROM
+					jobs
+				WHERE
+					status=$2 AND run_at <= now()
+				ORDER BY
+					run_at ASC, id ASC
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+		RETURNING
+			id, user_id, feed_id, status, attempts, last_error, run_at, started_at, created_at, payload
+	`
+
+	job, err := scanJob(s.db.QueryRow(query, model.JobStatusRunning, model.JobStatusQueued))
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to claim job: %v`, err)
+	}
+
+	return job, nil
+}
+
+// RemoveJob deletes a job from the queue once it is done or abandoned.
+func (s *Storage) RemoveJob(jobID int64) error {
+	if _, err := s.db.Exec(`DELETE FROM jobs WHERE id=$1`, jobID); err != nil {
+		return fmt.Errorf(`store: unable to remove job #%d: %v`, jobID, err)
+	}
+	return nil
+}
+
+// RetryJob puts back the job in the queue, to be run again after the given date.
+func (s *Storage) RetryJob(jobID int64, runAt time.Time, lastError string) error {
+	query := `UPDATE jobs SET status=$1, run_at=$2, last_error=$3, started_at=null WHERE id=$4`
+	if _, err := s.db.Exec(query, model.JobStatusQueued, runAt, lastError, jobID); err != nil {
+		return fmt.Errorf(`store: unable to reschedule job #%d: %v`, jobID, err)
+	}
+	return nil
+}
+
+// RequeueStaleJobs puts back in the queue the jobs running for too long, for example when an instance crashed.
+func (s *Storage) RequeueStaleJobs(timeout time.Duration) (int64, error) {
+	query := `
+		UPDATE
+			jobs
+		SET
+			status=$1,
+			started_at=null,
+			run_at=now()
+		WHERE
+			status=$2 AND started_at < $3
+	`
+	result, err := s.db.Exec(query, model.JobStatusQueued, model.JobStatusRunning, time.Now().Add(-timeout))
+	if err != nil {
+		return 0, fmt.Errorf(`store: unable to requeue stale jobs: %v`, err)
+	}
+
+	count, _ := result.RowsAffected()
+	return count, nil
+}
+
+// Jobs returns the queued and running jobs, without their payload.
+func (s *Storage) Jobs() (model.JobList, error) {
+	query := `
+		SELECT
+			id, user_id, feed_id, status, attempts, last_error, run_at, started_at, created_at, null
+		FROM
+			jobs
+		ORDER BY
+			status DESC, run_at ASC, id ASC
+	`
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch jobs: %v`, err)
+	}
+	defer rows.Close()
+
+	jobs := make(model.JobList, 0)
+	for rows.Next() {
+		job, err := scanJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch job row: %v`, err)
+		}
+		jobs = append(jobs, *job)
+	}
+
+	return jobs, nil
+}
+
+// CountAllJobs returns the number of jobs by status.
+func (s *Storage) CountAllJobs() map[string]int64 {
+	rows, err := s.db.Query(`SELECT status, count(*) FROM jobs GROUP BY status`)
+	if err != nil {
+		return nil
+	}
+	defer rows.Close()
+
+	results := make(map[string]int64)
+	results[model.JobStatusQueued] = 0
+	results[model.JobStatusRunning] = 0
+
+	for rows.Next() {
+		var status string
+		var count int64
+
+		if err := rows.Scan(&status, &count); err != nil {
+			continue
+		}
+
+		results[status] = count
+	}
+
+	return results
+}
+
+func scanJob(row rowScanner) (*model.Job, error) {
+	var job model.Job
+	var startedAt sql.NullTime
+
+	err := row.Scan(
+		&job.ID,
+		&job.UserID,
+		&job.FeedID,
+		&job.Status,
+		&job.Attempts,
+		&job.LastError,
+		&job.RunAt,
+		&startedAt,
+		&job.CreatedAt,
+		&job.Payload,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if startedAt.Valid {
+		job.StartedAt = &startedAt.Time
+	}
+
+	return &job, nil
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// AcquireLeadership returns true if the holder owns the named lease, which is granted or renewed for the given duration.
+//
+// Only one instance holds a lease at a time. The lease is transferred to another instance
+// when the current holder stops renewing it, for example after a crash.
+func (s *Storage) AcquireLeadership(name, holder string, duration time.Duration) (bool, error) {
+	query := `
+		INSERT INTO leader_leases
+			(name, holder, expires_at)
+		VALUES
+			($1, $2, now() + $3 * interval '1 second')
+		ON CONFLICT (name) DO UPDATE SET
+			holder=EXCLUDED.holder,
+			expires_at=EXCLUDED.expires_at
+		WHERE
+			leader_leases.holder=EXCLUDED.holder OR leader_leases.expires_at < now()
+		RETURNING
+			holder
+	`
+
+	var currentHolder string
+	err := s.db.QueryRow(query, name, holder, int64(duration.Seconds())).Scan(&currentHolder)
+	switch {
+	case err == sql.ErrNoRows:
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf(`store: unable to acquire lease %q: %v`, name, err)
+	}
+
+	return currentHolder == holder, nil
+}
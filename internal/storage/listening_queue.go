@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+
+	"github.com/lib/pq"
+)
+
+const listeningItemColumns = `
+	e.id,
+	e.user_id,
+	e.entry_id,
+	e.url,
+	e.size,
+	e.mime_type,
+	e.media_progression,
+	e.duration,
+	e.width,
+	e.height,
+	e.thumbnail_url,
+	e.title,
+	e.played,
+	entries.title,
+	entries.url,
+	feeds.id,
+	feeds.title,
+	COALESCE(feed_icons.icon_id, 0),
+	q.enclosure_id IS NOT NULL,
+	COALESCE(q.position, 0)
+`
+
+// ListeningQueue returns the enclosures of the listening queue of the user, in playing order.
+func (s *Storage) ListeningQueue(userID int64) (model.ListeningItems, error) {
+	query := `
+		SELECT ` + listeningItemColumns + `
+		FROM
+			listening_queue q
+		JOIN
+			enclosures e ON e.id=q.enclosure_id
+		JOIN
+			entries ON entries.id=e.entry_id
+		JOIN
+			feeds ON feeds.id=entries.feed_id
+		LEFT JOIN
+			feed_icons ON feed_icons.feed_id=feeds.id
+		WHERE
+			q.user_id=$1 AND entries.status <> 'removed'
+		ORDER BY
+			q.position ASC, q.created_at ASC
+	`
+
+	return s.fetchListeningItems(query, userID)
+}
+
+// ContinueListening returns the enclosures partially played by the user, the most recently listened first.
+func (s *Storage) ContinueListening(userID int64, limit int) (model.ListeningItems, error) {
+	query := `
+		SELECT ` + listeningItemColumns + `
+		FROM
+			enclosures e
+		JOIN
+			entries ON entries.id=e.entry_id
+		JOIN
+			feeds ON feeds.id=entries.feed_id
+		LEFT JOIN
+			feed_icons ON feed_icons.feed_id=feeds.id
+		LEFT JOIN
+			listening_queue q ON q.enclosure_id=e.id
+		WHERE
+			e.user_id=$1 AND e.media_progression > 0 AND e.played is false AND entries.status <> 'removed'
+		ORDER BY
+			e.media_progression_updated_at DESC NULLS LAST, e.id DESC
+		LIMIT $2
+	`
+
+	return s.fetchListeningItems(query, userID, limit)
+}
+
+func (s *Storage) fetchListeningItems(query string, args ...interface{}) (model.ListeningItems, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch listening items: %v`, err)
+	}
+	defer rows.Close()
+
+	items := make(model.ListeningItems, 0)
+	for rows.Next() {
+		item := model.ListeningItem{Enclosure: &model.Enclosure{}}
+		err := rows.Scan(
+			&item.Enclosure.ID,
+			&item.Enclosure.UserID,
+			&item.Enclosure.EntryID,
+			&item.Enclosure.URL,
+			&item.Enclosure.Size,
+			&item.Enclosure.MimeType,
+			&item.Enclosure.MediaProgression,
+			&item.Enclosure.Duration,
+			&item.Enclosure.Width,
+			&item.Enclosure.Height,
+			&item.Enclosure.ThumbnailURL,
+			&item.Enclosure.Title,
+			&item.Enclosure.Played,
+			&item.EntryTitle,
+			&item.EntryURL,
+			&item.FeedID,
+			&item.FeedTitle,
+			&item.IconID,
+			&item.Queued,
+			&item.Position,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch listening item row: %v`, err)
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// AddToListeningQueue appends an enclosure of the user at the end of the listening queue.
+func (s *Storage) AddToListeningQueue(userID, enclosureID int64) error {
+	query := `
+		INSERT INTO listening_queue
+			(user_id, enclosure_id, position)
+		SELECT
+			$1,
+			id,
+			(SELECT COALESCE(MAX(position) + 1, 0) FROM listening_queue WHERE user_id=$1)
+		FROM
+			enclosures
+		WHERE
+			user_id=$1 AND id=$2
+		ON CONFLICT (user_id, enclosure_id) DO NOTHING
+	`
+	if _, err := s.db.Exec(query, userID, enclosureID); err != nil {
+		return fmt.Errorf(`store: unable to add enclosure #%d to the listening queue: %v`, enclosureID, err)
+	}
+
+	return nil
+}
+
+// RemoveFromListeningQueue removes an enclosure from the listening queue of the user.
+func (s *Storage) RemoveFromListeningQueue(userID, enclosureID int64) error {
+	query := `DELETE FROM listening_queue WHERE user_id=$1 AND enclosure_id=$2`
+	if _, err := s.db.Exec(query, userID, enclosureID); err != nil {
+		return fmt.Errorf(`store: unable to remove enclosure #%d from the listening queue: %v`, enclosureID, err)
+	}
+
+	return nil
+}
+
+// ReorderListeningQueue moves the given enclosures at the beginning of the queue, in the given order.
+// Enclosures of the queue missing from the list keep their relative order after them.
+func (s *Storage) ReorderListeningQueue(userID int64, enclosureIDs []int64) error {
+	query := `
+		UPDATE
+			listening_queue q
+		SET
+			position=COALESCE(array_position($2::bigint[], q.enclosure_id) - 1, cardinality($2::bigint[]) + q.position)
+		WHERE
+			q.user_id=$1
+	`
+	if _, err := s.db.Exec(query, userID, pq.Array(enclosureIDs)); err != nil {
+		return fmt.Errorf(`store: unable to reorder the listening queue: %v`, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+const siteRuleColumns = `id, COALESCE(user_id, 0), user_id IS NULL, domain, scraper_rules, rewrite_rules, created_at, updated_at`
+
+// siteRuleOwner returns the value of the user_id column, instance-wide rules don't have any user.
+func siteRuleOwner(userID int64, instanceWide bool) any {
+	if instanceWide {
+		return nil
+	}
+	return userID
+}
+
+// SiteRuleDomainExists checks if another rule exists for the same domain, for the user or for the instance.
+func (s *Storage) SiteRuleDomainExists(userID int64, instanceWide bool, domain string, excludedSiteRuleID int64) bool {
+	var result bool
+	query := `SELECT true FROM site_rules WHERE user_id IS NOT DISTINCT FROM $1 AND domain=$2 AND id <> $3 LIMIT 1`
+	s.db.QueryRow(query, siteRuleOwner(userID, instanceWide), model.NormalizeSiteRuleDomain(domain), excludedSiteRuleID).Scan(&result)
+	return result
+}
+
+// SiteRules returns the site rules of the user and the instance-wide rules.
+func (s *Storage) SiteRules(userID int64) (model.SiteRules, error) {
+	query := `
+		SELECT
+			` + siteRuleColumns + `
+		FROM
+			site_rules
+		WHERE
+			user_id=$1 OR user_id IS NULL
+		ORDER BY user_id IS NULL ASC, domain ASC
+	`
+	return s.fetchSiteRules(query, userID)
+}
+
+// MatchingSiteRules returns the site rules of the given hostname and its parent domains.
+// The rules of the user come first, then the instance-wide rules, the most specific domain first.
+func (s *Storage) MatchingSiteRules(userID int64, hostname string) (model.SiteRules, error) {
+	query := `
+		SELECT
+			` + siteRuleColumns + `
+		FROM
+			site_rules
+		WHERE
+			(user_id=$1 OR user_id IS NULL) AND (domain=$2 OR right($2, length(domain) + 1) = '.' || domain)
+		ORDER BY user_id IS NULL ASC, length(domain) DESC
+	`
+	return s.fetchSiteRules(query, userID, model.NormalizeSiteRuleDomain(hostname))
+}
+
+// SiteRule returns a site rule of the user or an instance-wide rule.
+func (s *Storage) SiteRule(userID, siteRuleID int64) (*model.SiteRule, error) {
+	query := `
+		SELECT
+			` + siteRuleColumns + `
+		FROM
+			site_rules
+		WHERE
+			id=$1 AND (user_id=$2 OR user_id IS NULL)
+	`
+	siteRules, err := s.fetchSiteRules(query, siteRuleID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(siteRules) == 0 {
+		return nil, nil
+	}
+
+	return siteRules[0], nil
+}
+
+// CreateSiteRule inserts a new site rule.
+func (s *Storage) CreateSiteRule(userID int64, request *model.SiteRuleRequest) (*model.SiteRule, error) {
+	siteRule := &model.SiteRule{
+		InstanceWide: request.InstanceWide,
+		Domain:       model.NormalizeSiteRuleDomain(request.Domain),
+		ScraperRules: request.ScraperRules,
+		RewriteRules: request.RewriteRules,
+	}
+
+	if !request.InstanceWide {
+		siteRule.UserID = userID
+	}
+
+	query := `
+		INSERT INTO site_rules
+			(user_id, domain, scraper_rules, rewrite_rules)
+		VALUES
+			($1, $2, $3, $4)
+		RETURNING
+			id, created_at, updated_at
+	`
+	err := s.db.QueryRow(
+		query,
+		siteRuleOwner(userID, request.InstanceWide),
+		siteRule.Domain,
+		siteRule.ScraperRules,
+		siteRule.RewriteRules,
+	).Scan(
+		&siteRule.ID,
+		&siteRule.CreatedAt,
+		&siteRule.UpdatedAt,
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to create site rule %q: %v`, siteRule.Domain, err)
+	}
+
+	return siteRule, nil
+}
+
+// UpdateSiteRule updates an existing site rule.
+func (s *Storage) UpdateSiteRule(siteRule *model.SiteRule) error {
+	query := `
+		UPDATE
+			site_rules
+		SET
+			domain=$1, scraper_rules=$2, rewrite_rules=$3, updated_at=now()
+		WHERE
+			id=$4
+		RETURNING
+			updated_at
+	`
+	err := s.db.QueryRow(
+		query,
+		model.NormalizeSiteRuleDomain(siteRule.Domain),
+		siteRule.ScraperRules,
+		siteRule.RewriteRules,
+		siteRule.ID,
+	).Scan(&siteRule.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf(`store: unable to update site rule #%d: %v`, siteRule.ID, err)
+	}
+
+	return nil
+}
+
+// RemoveSiteRule deletes a site rule.
+func (s *Storage) RemoveSiteRule(siteRuleID int64) error {
+	query := `DELETE FROM site_rules WHERE id=$1`
+	if _, err := s.db.Exec(query, siteRuleID); err != nil {
+		return fmt.Errorf(`store: unable to remove site rule #%d: %v`, siteRuleID, err)
+	}
+
+	return nil
+}
+
+// ImportSiteRules creates or replaces the site rules having the same domain, in a single transaction.
+func (s *Storage) ImportSiteRules(userID int64, requests model.SiteRuleRequests) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf(`store: unable to start transaction: %v`, err)
+	}
+
+	for _, request := range requests {
+		if err := importSiteRule(tx, userID, request); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf(`store: unable to commit transaction: %v`, err)
+	}
+
+	return nil
+}
+
+func importSiteRule(tx *sql.Tx, userID int64, request *model.SiteRuleRequest) error {
+	owner := siteRuleOwner(userID, request.InstanceWide)
+	domain := model.NormalizeSiteRuleDomain(request.Domain)
+
+	result, err := tx.Exec(
+		`UPDATE site_rules SET scraper_rules=$1, rewrite_rules=$2, updated_at=now() WHERE user_id IS NOT DISTINCT FROM $3 AND domain=$4`,
+		request.ScraperRules,
+		request.RewriteRules,
+		owner,
+		domain,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to import site rule %q: %v`, domain, err)
+	}
+
+	if count, _ := result.RowsAffected(); count > 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(
+		`INSERT INTO site_rules (user_id, domain, scraper_rules, rewrite_rules) VALUES ($1, $2, $3, $4)`,
+		owner,
+		domain,
+		request.ScraperRules,
+		request.RewriteRules,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to import site rule %q: %v`, domain, err)
+	}
+
+	return nil
+}
+
+func (s *Storage) fetchSiteRules(query string, args ...any) (model.SiteRules, error) {
+	rows, err := s.db.Query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch site rules: %v`, err)
+	}
+	defer rows.Close()
+
+	siteRules := make(model.SiteRules, 0)
+	for rows.Next() {
+		var siteRule model.SiteRule
+		if err := rows.Scan(
+			&siteRule.ID,
+			&siteRule.UserID,
+			&siteRule.InstanceWide,
+			&siteRule.Domain,
+			&siteRule.ScraperRules,
+			&siteRule.RewriteRules,
+			&siteRule.CreatedAt,
+			&siteRule.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch site rule row: %v`, err)
+		}
+
+		siteRules = append(siteRules, &siteRule)
+	}
+
+	return siteRules, nil
+}
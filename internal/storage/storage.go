@@ -14,6 +14,11 @@ type Storage struct {
 	db *sql.DB
 }
 
+// rowScanner is implemented by *sql.Row and *sql.Rows, to share the scanning code of single and multiple rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
 // NewStorage returns a new Storage.
 func NewStorage(db *sql.DB) *Storage {
 	return &Storage{db}
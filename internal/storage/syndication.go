@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+// SyndicationToken returns the token used to protect the Atom feeds of the user, or an empty string if none has been generated.
+func (s *Storage) SyndicationToken(userID int64) (string, error) {
+	var token string
+	err := s.db.QueryRow(`SELECT token FROM syndication_tokens WHERE user_id=$1`, userID).Scan(&token)
+
+	switch {
+	case err == sql.ErrNoRows:
+		return "", nil
+	case err != nil:
+		return "", fmt.Errorf(`store: unable to fetch syndication token: %v`, err)
+	}
+
+	return token, nil
+}
+
+// RegenerateSyndicationToken creates or replaces the token of the user, the previous feed URLs stop working.
+func (s *Storage) RegenerateSyndicationToken(userID int64) (string, error) {
+	query := `
+		INSERT INTO syndication_tokens
+			(user_id, token)
+		VALUES
+			($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET
+			token=EXCLUDED.token,
+			created_at=now()
+		RETURNING
+			token
+	`
+
+	var token string
+	if err := s.db.QueryRow(query, userID, crypto.GenerateRandomStringHex(20)).Scan(&token); err != nil {
+		return "", fmt.Errorf(`store: unable to regenerate syndication token: %v`, err)
+	}
+
+	return token, nil
+}
+
+// UserBySyndicationToken returns the user owning the given syndication token.
+func (s *Storage) UserBySyndicationToken(token string) (*model.User, error) {
+	query := `
+		SELECT
+			users.id, users.username, users.is_admin, users.timezone
+		FROM
+			users
+		JOIN
+			syndication_tokens ON syndication_tokens.user_id=users.id
+		WHERE
+			syndication_tokens.token=$1
+	`
+
+	var user model.User
+	err := s.db.QueryRow(query, token).Scan(&user.ID, &user.Username, &user.IsAdmin, &user.Timezone)
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("store: unable to fetch user: %v", err)
+	default:
+		return &user, nil
+	}
+}
@@ -92,7 +92,11 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 			default_home_page,
 			categories_sorting_order,
 			mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 	`
 
 	tx, err := s.db.Begin()
@@ -132,6 +136,10 @@ func (s *Storage) CreateUser(userCreationRequest *model.UserCreationRequest) (*m
 		&user.CategoriesSortingOrder,
 		&user.MarkReadOnView,
 		&user.MediaPlaybackRate,
+		&user.BlockFilterEntryRules,
+		&user.KeepFilterEntryRules,
+		&user.EntryActionRules,
+		&user.DeduplicateEntries,
 	)
 	if err != nil {
 		tx.Rollback()
@@ -189,9 +197,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				default_home_page=$20,
 				categories_sorting_order=$21,
 				mark_read_on_view=$22,
-				media_playback_rate=$23
+				media_playback_rate=$23,
+				block_filter_entry_rules=$24,
+				keep_filter_entry_rules=$25,
+				entry_action_rules=$26,
+				deduplicate_entries=$27
 			WHERE
-				id=$24
+				id=$28
 		`
 
 		_, err = s.db.Exec(
@@ -219,6 +231,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.CategoriesSortingOrder,
 			user.MarkReadOnView,
 			user.MediaPlaybackRate,
+			user.BlockFilterEntryRules,
+			user.KeepFilterEntryRules,
+			user.EntryActionRules,
+			user.DeduplicateEntries,
 			user.ID,
 		)
 		if err != nil {
@@ -248,9 +264,13 @@ func (s *Storage) UpdateUser(user *model.User) error {
 				default_home_page=$19,
 				categories_sorting_order=$20,
 				mark_read_on_view=$21,
-				media_playback_rate=$22
+				media_playback_rate=$22,
+				block_filter_entry_rules=$23,
+				keep_filter_entry_rules=$24,
+				entry_action_rules=$25,
+				deduplicate_entries=$26
 			WHERE
-				id=$23
+				id=$27
 		`
 
 		_, err := s.db.Exec(
@@ -277,6 +297,10 @@ func (s *Storage) UpdateUser(user *model.User) error {
 			user.CategoriesSortingOrder,
 			user.MarkReadOnView,
 			user.MediaPlaybackRate,
+			user.BlockFilterEntryRules,
+			user.KeepFilterEntryRules,
+			user.EntryActionRules,
+			user.DeduplicateEntries,
 			user.ID,
 		)
 
@@ -325,7 +349,11 @@ func (s *Storage) UserByID(userID int64) (*model.User, error) {
 			default_home_page,
 			categories_sorting_order,
 			mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 		FROM
 			users
 		WHERE
@@ -361,7 +389,11 @@ func (s *Storage) UserByUsername(username string) (*model.User, error) {
 			default_home_page,
 			categories_sorting_order,
 			mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 		FROM
 			users
 		WHERE
@@ -397,7 +429,11 @@ func (s *Storage) UserByField(field, value string) (*model.User, error) {
 			default_home_page,
 			categories_sorting_order,
 			mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 		FROM
 			users
 		WHERE
@@ -440,7 +476,11 @@ func (s *Storage) UserByAPIKey(token string) (*model.User, error) {
 			u.default_home_page,
 			u.categories_sorting_order,
 			u.mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 		FROM
 			users u
 		LEFT JOIN
@@ -478,6 +518,10 @@ func (s *Storage) fetchUser(query string, args ...interface{}) (*model.User, err
 		&user.CategoriesSortingOrder,
 		&user.MarkReadOnView,
 		&user.MediaPlaybackRate,
+		&user.BlockFilterEntryRules,
+		&user.KeepFilterEntryRules,
+		&user.EntryActionRules,
+		&user.DeduplicateEntries,
 	)
 
 	if err == sql.ErrNoRows {
@@ -586,7 +630,11 @@ func (s *Storage) Users() (model.Users, error) {
 			default_home_page,
 			categories_sorting_order,
 			mark_read_on_view,
-			media_playback_rate
+			media_playback_rate,
+			block_filter_entry_rules,
+			keep_filter_entry_rules,
+			entry_action_rules,
+			deduplicate_entries
 		FROM
 			users
 		ORDER BY username ASC
@@ -625,6 +673,10 @@ func (s *Storage) Users() (model.Users, error) {
 			&user.CategoriesSortingOrder,
 			&user.MarkReadOnView,
 			&user.MediaPlaybackRate,
+			&user.BlockFilterEntryRules,
+			&user.KeepFilterEntryRules,
+			&user.EntryActionRules,
+			&user.DeduplicateEntries,
 		)
 
 		if err != nil {
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package storage // import "miniflux.app/v2/internal/storage"
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"miniflux.app/v2/internal/crypto"
+	"miniflux.app/v2/internal/model"
+)
+
+// SaveWebSubHub records the hub advertised by a feed.
+// The subscription has to be requested again when the hub or the topic changes.
+func (s *Storage) SaveWebSubHub(userID, feedID int64, hubURL, topicURL string) error {
+	query := `
+		INSERT INTO websub_subscriptions
+			(feed_id, user_id, hub_url, topic_url, callback_token, secret)
+		VALUES
+			($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (feed_id) DO UPDATE SET
+			hub_url=EXCLUDED.hub_url,
+			topic_url=EXCLUDED.topic_url,
+			state=$7,
+			lease_expires_at=NULL,
+			requested_at=NULL
+		WHERE
+			websub_subscriptions.hub_url <> EXCLUDED.hub_url OR websub_subscriptions.topic_url <> EXCLUDED.topic_url
+	`
+	_, err := s.db.Exec(
+		query,
+		feedID,
+		userID,
+		hubURL,
+		topicURL,
+		crypto.GenerateRandomStringHex(32),
+		crypto.GenerateRandomStringHex(32),
+		model.WebSubStatePending,
+	)
+	if err != nil {
+		return fmt.Errorf(`store: unable to save WebSub hub for feed #%d: %v`, feedID, err)
+	}
+
+	return nil
+}
+
+// RemoveWebSubSubscription deletes the WebSub subscription of a feed.
+func (s *Storage) RemoveWebSubSubscription(feedID int64) error {
+	if _, err := s.db.Exec(`DELETE FROM websub_subscriptions WHERE feed_id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to remove WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+	return nil
+}
+
+// WebSubSubscriptionByToken returns the subscription associated with a callback token, or nil if there is none.
+func (s *Storage) WebSubSubscriptionByToken(callbackToken string) (*model.WebSubSubscription, error) {
+	query := `
+		SELECT
+			feed_id, user_id, hub_url, topic_url, callback_token, secret, state, lease_expires_at, requested_at
+		FROM
+			websub_subscriptions
+		WHERE
+			callback_token=$1
+	`
+
+	subscription, err := scanWebSubSubscription(s.db.QueryRow(query, callbackToken))
+	switch {
+	case err == sql.ErrNoRows:
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscription: %v`, err)
+	}
+
+	return subscription, nil
+}
+
+// WebSubSubscriptionsToRenew returns the subscriptions of enabled feeds that were never verified or that expire soon.
+// Subscriptions requested less than retryInterval ago are skipped, the hub has not answered yet.
+func (s *Storage) WebSubSubscriptionsToRenew(renewBefore, retryInterval time.Duration) ([]*model.WebSubSubscription, error) {
+	query := `
+		SELECT
+			s.feed_id, s.user_id, s.hub_url, s.topic_url, s.callback_token, s.secret, s.state, s.lease_expires_at, s.requested_at
+		FROM
+			websub_subscriptions s
+		JOIN
+			feeds f ON f.id=s.feed_id
+		WHERE
+			f.disabled IS false AND
+			s.state <> $1 AND
+			(s.lease_expires_at IS NULL OR s.lease_expires_at < now() + $2 * interval '1 second') AND
+			(s.requested_at IS NULL OR s.requested_at < now() - $3 * interval '1 second')
+		ORDER BY
+			s.requested_at ASC NULLS FIRST
+	`
+
+	rows, err := s.db.Query(query, model.WebSubStateDenied, int(renewBefore.Seconds()), int(retryInterval.Seconds()))
+	if err != nil {
+		return nil, fmt.Errorf(`store: unable to fetch WebSub subscriptions to renew: %v`, err)
+	}
+	defer rows.Close()
+
+	var subscriptions []*model.WebSubSubscription
+	for rows.Next() {
+		subscription, err := scanWebSubSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf(`store: unable to fetch WebSub subscription row: %v`, err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+// MarkWebSubSubscriptionRequested records that a subscription request has been sent to the hub.
+func (s *Storage) MarkWebSubSubscriptionRequested(feedID int64) error {
+	if _, err := s.db.Exec(`UPDATE websub_subscriptions SET requested_at=now() WHERE feed_id=$1`, feedID); err != nil {
+		return fmt.Errorf(`store: unable to update WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+	return nil
+}
+
+// VerifyWebSubSubscription activates a subscription confirmed by the hub for the given lease.
+func (s *Storage) VerifyWebSubSubscription(feedID int64, lease time.Duration) error {
+	query := `
+		UPDATE
+			websub_subscriptions
+		SET
+			state=$1,
+			lease_expires_at=now() + $2 * interval '1 second'
+		WHERE
+			feed_id=$3
+	`
+	if _, err := s.db.Exec(query, model.WebSubStateVerified, int(lease.Seconds()), feedID); err != nil {
+		return fmt.Errorf(`store: unable to verify WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+	return nil
+}
+
+// DenyWebSubSubscription records that the hub refused the subscription.
+func (s *Storage) DenyWebSubSubscription(feedID int64) error {
+	query := `UPDATE websub_subscriptions SET state=$1, lease_expires_at=NULL WHERE feed_id=$2`
+	if _, err := s.db.Exec(query, model.WebSubStateDenied, feedID); err != nil {
+		return fmt.Errorf(`store: unable to deny WebSub subscription for feed #%d: %v`, feedID, err)
+	}
+	return nil
+}
+
+// HasActiveWebSubSubscription returns true if the feed receives push updates from its hub.
+func (s *Storage) HasActiveWebSubSubscription(feedID int64) bool {
+	var result bool
+	query := `
+		SELECT
+			true
+		FROM
+			websub_subscriptions
+		WHERE
+			feed_id=$1 AND state=$2 AND lease_expires_at > now()
+	`
+	s.db.QueryRow(query, feedID, model.WebSubStateVerified).Scan(&result)
+	return result
+}
+
+func scanWebSubSubscription(row rowScanner) (*model.WebSubSubscription, error) {
+	var subscription model.WebSubSubscription
+	err := row.Scan(
+		&subscription.FeedID,
+		&subscription.UserID,
+		&subscription.HubURL,
+		&subscription.TopicURL,
+		&subscription.CallbackToken,
+		&subscription.Secret,
+		&subscription.State,
+		&subscription.LeaseExpiresAt,
+		&subscription.RequestedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &subscription, nil
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/mediaproxy"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// maxEntries is the number of most recent entries included in a generated feed.
+const maxEntries = 100
+
+// NewEntryQueryBuilder returns a query builder for the most recent entries of the user published in a generated feed.
+func NewEntryQueryBuilder(store *storage.Storage, userID int64) *storage.EntryQueryBuilder {
+	builder := store.NewEntryQueryBuilder(userID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithSorting(model.DefaultSortingOrder, "desc")
+	builder.WithLimit(maxEntries)
+	builder.WithEnclosures()
+	return builder
+}
+
+// FetchEntries returns the entries of a generated feed, the media of the content use absolute proxy URLs.
+func FetchEntries(router *mux.Router, r *http.Request, builder *storage.EntryQueryBuilder) (model.Entries, error) {
+	entries, err := builder.GetEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range entries {
+		entries[i].Content = mediaproxy.RewriteDocumentWithAbsoluteProxyURL(router, r.Host, entries[i].Content)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+// Package syndication publishes token-protected Atom feeds of the user entries.
+package syndication // import "miniflux.app/v2/internal/syndication"
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/atom"
+	"miniflux.app/v2/internal/storage"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the Atom feed routes.
+func Serve(router *mux.Router, store *storage.Storage) {
+	handler := &handler{store, router}
+
+	sr := router.PathPrefix("/atom/{token}").Subrouter()
+	sr.Use(newMiddleware(store).serve)
+	sr.HandleFunc("/starred", handler.starredFeed).Name("syndicationStarred").Methods(http.MethodGet)
+	sr.HandleFunc("/shared", handler.sharedFeed).Name("syndicationShared").Methods(http.MethodGet)
+	sr.HandleFunc("/search", handler.searchFeed).Name("syndicationSearch").Methods(http.MethodGet)
+	sr.HandleFunc("/category/{categoryID}", handler.categoryFeed).Name("syndicationCategory").Methods(http.MethodGet)
+}
+
+type handler struct {
+	store  *storage.Storage
+	router *mux.Router
+}
+
+func (h *handler) starredFeed(w http.ResponseWriter, r *http.Request) {
+	builder := NewEntryQueryBuilder(h.store, request.UserID(r))
+	builder.WithStarred(true)
+
+	h.writeFeed(w, r, builder, atom.FeedProperties{
+		Title:        "Starred",
+		AlternateURL: config.Opts.RootURL() + route.Path(h.router, "starred"),
+	})
+}
+
+func (h *handler) sharedFeed(w http.ResponseWriter, r *http.Request) {
+	builder := NewEntryQueryBuilder(h.store, request.UserID(r))
+	builder.WithShareCodeNotEmpty()
+
+	h.writeFeed(w, r, builder, atom.FeedProperties{
+		Title:        "Shared",
+		AlternateURL: config.Opts.RootURL() + route.Path(h.router, "sharedEntries"),
+		EntryURL: func(entry *model.Entry) string {
+			return config.Opts.RootURL() + route.Path(h.router, "sharedEntry", "shareCode", entry.ShareCode)
+		},
+	})
+}
+
+func (h *handler) searchFeed(w http.ResponseWriter, r *http.Request) {
+	searchQuery := request.QueryStringParam(r, "q", "")
+	if searchQuery == "" {
+		html.BadRequest(w, r, errors.New("the search query is required"))
+		return
+	}
+
+	builder := NewEntryQueryBuilder(h.store, request.UserID(r))
+	builder.WithSearchQuery(searchQuery)
+
+	h.writeFeed(w, r, builder, atom.FeedProperties{
+		Title:        "Search: " + searchQuery,
+		AlternateURL: config.Opts.RootURL() + route.Path(h.router, "search") + "?q=" + url.QueryEscape(searchQuery),
+	})
+}
+
+func (h *handler) categoryFeed(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	categoryID := request.RouteInt64Param(r, "categoryID")
+
+	category, err := h.store.Category(userID, categoryID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if category == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := NewEntryQueryBuilder(h.store, userID)
+	builder.WithCategoryID(categoryID)
+
+	h.writeFeed(w, r, builder, atom.FeedProperties{
+		Title:        category.Title,
+		AlternateURL: config.Opts.RootURL() + route.Path(h.router, "categoryEntries", "categoryID", categoryID),
+	})
+}
+
+func (h *handler) writeFeed(w http.ResponseWriter, r *http.Request, builder *storage.EntryQueryBuilder, properties atom.FeedProperties) {
+	entries, err := FetchEntries(h.router, r, builder)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	properties.SelfURL = config.Opts.RootURL() + r.URL.RequestURI()
+	properties.ID = properties.SelfURL
+
+	body, err := atom.Serialize(properties, entries)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	response.New(w, r).WithHeader("Content-Type", "application/atom+xml; charset=utf-8").WithBody(body).Write()
+}
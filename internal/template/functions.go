@@ -33,14 +33,15 @@ type funcMap struct {
 // Map returns a map of template functions that are compiled during template parsing.
 func (f *funcMap) Map() template.FuncMap {
 	return template.FuncMap{
-		"formatFileSize": formatFileSize,
-		"dict":           dict,
-		"hasKey":         hasKey,
-		"truncate":       truncate,
-		"isEmail":        isEmail,
-		"buildQuery":     buildQuery,
-		"baseURL":        config.Opts.BaseURL,
-		"rootURL":        config.Opts.RootURL,
+		"formatFileSize":      formatFileSize,
+		"formatMediaDuration": formatMediaDuration,
+		"dict":                dict,
+		"hasKey":              hasKey,
+		"truncate":            truncate,
+		"isEmail":             isEmail,
+		"buildQuery":          buildQuery,
+		"baseURL":             config.Opts.BaseURL,
+		"rootURL":             config.Opts.RootURL,
 		"hasOAuth2Provider": func(provider string) bool {
 			return config.Opts.OAuth2Provider() == provider
 		},
@@ -217,6 +218,19 @@ func formatFileSize(b int64) string {
 	return fmt.Sprintf("%.1f %ciB", number, "KMGTPE"[int64(base)-1])
 }
 
+// formatMediaDuration converts a number of seconds to the clock format used by media players, like 1:02:03 or 4:05.
+func formatMediaDuration(seconds int) string {
+	if seconds <= 0 {
+		return "0:00"
+	}
+
+	hours, minutes, seconds := seconds/3600, (seconds%3600)/60, seconds%60
+	if hours > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", hours, minutes, seconds)
+	}
+	return fmt.Sprintf("%d:%02d", minutes, seconds)
+}
+
 func buildQuery(args ...interface{}) string {
 	vals := netUrl.Values{}
 	for i := 0; i < len(args)-1; i += 2 {
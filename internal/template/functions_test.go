@@ -173,6 +173,27 @@ func TestFormatFileSize(t *testing.T) {
 	}
 }
 
+func TestFormatMediaDuration(t *testing.T) {
+	scenarios := []struct {
+		input    int
+		expected string
+	}{
+		{-1, "0:00"},
+		{0, "0:00"},
+		{5, "0:05"},
+		{245, "4:05"},
+		{3600, "1:00:00"},
+		{3723, "1:02:03"},
+	}
+
+	for _, scenario := range scenarios {
+		result := formatMediaDuration(scenario.input)
+		if result != scenario.expected {
+			t.Errorf(`Unexpected result, got %q instead of %q for %d`, result, scenario.expected, scenario.input)
+		}
+	}
+}
+
 func TestBuildQuery(t *testing.T) {
 	anyTime := time.Now()
 
@@ -33,8 +33,10 @@ func (h *handler) showEditCategoryPage(w http.ResponseWriter, r *http.Request) {
 	}
 
 	categoryForm := form.CategoryForm{
-		Title:        category.Title,
-		HideGlobally: "",
+		Title:          category.Title,
+		HideGlobally:   "",
+		BlocklistRules: category.BlocklistRules,
+		KeeplistRules:  category.KeeplistRules,
 	}
 	if category.HideGlobally {
 		categoryForm.HideGlobally = "checked"
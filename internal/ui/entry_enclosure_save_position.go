@@ -12,6 +12,7 @@ import (
 )
 
 func (h *handler) saveEnclosureProgression(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
 	enclosureID := request.RouteInt64Param(r, "enclosureID")
 	enclosure, err := h.store.GetEnclosure(enclosureID)
 	if err != nil {
@@ -19,13 +20,14 @@ func (h *handler) saveEnclosureProgression(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	if enclosure == nil {
+	if enclosure == nil || enclosure.UserID != userID {
 		json.NotFound(w, r)
 		return
 	}
 
 	type enclosurePositionSaveRequest struct {
 		Progression int64 `json:"progression"`
+		Played      bool  `json:"played"`
 	}
 
 	var postData enclosurePositionSaveRequest
@@ -33,12 +35,19 @@ func (h *handler) saveEnclosureProgression(w http.ResponseWriter, r *http.Reques
 		json.ServerError(w, r, err)
 		return
 	}
-	enclosure.MediaProgression = postData.Progression
 
-	if err := h.store.UpdateEnclosure(enclosure); err != nil {
+	if err := h.store.UpdateEnclosureProgression(userID, enclosure.ID, postData.Progression); err != nil {
 		json.ServerError(w, r, err)
 		return
 	}
 
+	// The player reports the end of the media, the enclosure is not resumed anymore.
+	if postData.Played {
+		if err := h.store.SetEnclosurePlayed(userID, enclosure.ID, true); err != nil {
+			json.ServerError(w, r, err)
+			return
+		}
+	}
+
 	json.Created(w, r, map[string]string{"message": "saved"})
 }
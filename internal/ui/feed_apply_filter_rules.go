@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) applyFeedFilterRules(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(user.ID, feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	filterRulesRequest := &model.FilterRulesRequest{
+		Action: r.FormValue("action"),
+		DryRun: r.FormValue("dry_run") == "1",
+	}
+
+	printer := locale.NewPrinter(user.Language)
+	sess := session.New(h.store, request.SessionID(r))
+
+	if validationErr := validator.ValidateFilterRulesRequest(filterRulesRequest); validationErr != nil {
+		sess.NewFlashErrorMessage(validationErr.Translate(user.Language))
+		html.Redirect(w, r, route.Path(h.router, "editFeed", "feedID", feed.ID))
+		return
+	}
+
+	result, err := processor.FilterStoredEntries(h.store, user, model.Feeds{feed}, filterRulesRequest.Action, filterRulesRequest.DryRun)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	slog.Info("Applied filter rules to stored entries from the web ui",
+		slog.Int64("user_id", user.ID),
+		slog.Int64("feed_id", feed.ID),
+		slog.String("action", result.Action),
+		slog.Bool("dry_run", result.DryRun),
+		slog.Int("checked_entries", result.CheckedEntries),
+		slog.Int("matched_entries", result.MatchedEntries),
+	)
+
+	if result.DryRun {
+		sess.NewFlashMessage(printer.Printf("alert.filter_rules_dry_run", result.MatchedEntries, result.CheckedEntries))
+		html.Redirect(w, r, route.Path(h.router, "editFeed", "feedID", feed.ID))
+		return
+	}
+
+	sess.NewFlashMessage(printer.Printf("alert.filter_rules_applied", result.MatchedEntries, result.CheckedEntries))
+	html.Redirect(w, r, route.Path(h.router, "feedEntries", "feedID", feed.ID))
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/processor"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+// scraperPreviewMaxEntries is the number of recent entries that can be selected to test the rules.
+const scraperPreviewMaxEntries = 20
+
+func (h *handler) showScraperPreviewPage(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	feedID := request.RouteInt64Param(r, "feedID")
+	feed, err := h.store.FeedByID(user.ID, feedID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if feed == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	builder := h.store.NewEntryQueryBuilder(user.ID)
+	builder.WithFeedID(feed.ID)
+	builder.WithoutStatus(model.EntryStatusRemoved)
+	builder.WithSorting(model.DefaultSortingOrder, "desc")
+	builder.WithLimit(scraperPreviewMaxEntries)
+	entries, err := builder.GetEntries()
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	previewForm := &form.ScraperPreviewForm{
+		ScraperRules: feed.ScraperRules,
+		RewriteRules: feed.RewriteRules,
+	}
+	if r.Method == http.MethodPost {
+		previewForm = form.NewScraperPreviewForm(r)
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", previewForm)
+	view.Set("feed", feed)
+	view.Set("entries", entries)
+	view.Set("menu", "feeds")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	if r.Method == http.MethodPost && len(entries) > 0 {
+		entry := entries[0]
+		for _, e := range entries {
+			if e.ID == previewForm.EntryID {
+				entry = e
+			}
+		}
+
+		feed.ScraperRules = previewForm.ScraperRules
+		feed.RewriteRules = previewForm.RewriteRules
+
+		if validationErr := validator.ValidateRewriteRules(feed.RewriteRules); validationErr != nil {
+			view.Set("errorMessage", validationErr.Translate(user.Language))
+		} else if content, err := processor.PreviewEntryWebPage(h.store, feed, entry); err != nil {
+			view.Set("errorMessage", err.Error())
+		} else {
+			view.Set("preview", &model.ScraperPreview{
+				EntryID:         entry.ID,
+				URL:             entry.URL,
+				ScraperRules:    feed.ScraperRules,
+				RewriteRules:    feed.RewriteRules,
+				OriginalContent: entry.Content,
+				Content:         content,
+			})
+		}
+	}
+
+	html.OK(w, r, view.Render("scraper_preview"))
+}
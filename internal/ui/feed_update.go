@@ -62,7 +62,9 @@ func (h *handler) updateFeed(w http.ResponseWriter, r *http.Request) {
 		CategoryID:      model.OptionalNumber(feedForm.CategoryID),
 		BlocklistRules:  model.OptionalString(feedForm.BlocklistRules),
 		KeeplistRules:   model.OptionalString(feedForm.KeeplistRules),
+		RewriteRules:    model.OptionalString(feedForm.RewriteRules),
 		UrlRewriteRules: model.OptionalString(feedForm.UrlRewriteRules),
+		PageSelectors:   &feedForm.PageSelectors,
 	}
 
 	if validationErr := validator.ValidateFeedModification(h.store, loggedUser.ID, feed.ID, feedModificationRequest); validationErr != nil {
@@ -9,14 +9,18 @@ import (
 
 // CategoryForm represents a feed form in the UI
 type CategoryForm struct {
-	Title        string
-	HideGlobally string
+	Title          string
+	HideGlobally   string
+	BlocklistRules string
+	KeeplistRules  string
 }
 
 // NewCategoryForm returns a new CategoryForm.
 func NewCategoryForm(r *http.Request) *CategoryForm {
 	return &CategoryForm{
-		Title:        r.FormValue("title"),
-		HideGlobally: r.FormValue("hide_globally"),
+		Title:          r.FormValue("title"),
+		HideGlobally:   r.FormValue("hide_globally"),
+		BlocklistRules: r.FormValue("blocklist_rules"),
+		KeeplistRules:  r.FormValue("keeplist_rules"),
 	}
 }
@@ -6,6 +6,7 @@ package form // import "miniflux.app/v2/internal/ui/form"
 import (
 	"net/http"
 	"strconv"
+	"strings"
 
 	"miniflux.app/v2/internal/model"
 )
@@ -36,6 +37,7 @@ type FeedForm struct {
 	CategoryHidden              bool // Category has "hide_globally"
 	AppriseServiceURLs          string
 	DisableHTTP2                bool
+	PageSelectors               model.PageSelectors
 }
 
 // Merge updates the fields of the given feed.
@@ -65,6 +67,7 @@ func (f FeedForm) Merge(feed *model.Feed) *model.Feed {
 	feed.HideGlobally = f.HideGlobally
 	feed.AppriseServiceURLs = f.AppriseServiceURLs
 	feed.DisableHTTP2 = f.DisableHTTP2
+	feed.PageSelectors = f.PageSelectors
 	return feed
 }
 
@@ -98,5 +101,17 @@ func NewFeedForm(r *http.Request) *FeedForm {
 		HideGlobally:                r.FormValue("hide_globally") == "1",
 		AppriseServiceURLs:          r.FormValue("apprise_service_urls"),
 		DisableHTTP2:                r.FormValue("disable_http2") == "1",
+		PageSelectors:               NewPageSelectors(r),
+	}
+}
+
+// NewPageSelectors parses the CSS selectors used to generate a feed from a web page.
+func NewPageSelectors(r *http.Request) model.PageSelectors {
+	return model.PageSelectors{
+		Item:    strings.TrimSpace(r.FormValue("page_item_selector")),
+		Title:   strings.TrimSpace(r.FormValue("page_title_selector")),
+		Link:    strings.TrimSpace(r.FormValue("page_link_selector")),
+		Date:    strings.TrimSpace(r.FormValue("page_date_selector")),
+		Content: strings.TrimSpace(r.FormValue("page_content_selector")),
 	}
 }
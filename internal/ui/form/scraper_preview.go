@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// ScraperPreviewForm represents the form used to test scraper and rewrite rules on an entry.
+type ScraperPreviewForm struct {
+	EntryID      int64
+	ScraperRules string
+	RewriteRules string
+}
+
+// NewScraperPreviewForm returns a new ScraperPreviewForm.
+func NewScraperPreviewForm(r *http.Request) *ScraperPreviewForm {
+	entryID, _ := strconv.ParseInt(r.FormValue("entry_id"), 10, 64)
+
+	return &ScraperPreviewForm{
+		EntryID:      entryID,
+		ScraperRules: r.FormValue("scraper_rules"),
+		RewriteRules: r.FormValue("rewrite_rules"),
+	}
+}
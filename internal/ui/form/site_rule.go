@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package form // import "miniflux.app/v2/internal/ui/form"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// SiteRuleForm represents a site rule form in the UI.
+type SiteRuleForm struct {
+	Domain       string
+	ScraperRules string
+	RewriteRules string
+	InstanceWide bool
+}
+
+// CreationRequest returns the request to create the site rule.
+func (s SiteRuleForm) CreationRequest() *model.SiteRuleRequest {
+	return &model.SiteRuleRequest{
+		Domain:       s.Domain,
+		ScraperRules: s.ScraperRules,
+		RewriteRules: s.RewriteRules,
+		InstanceWide: s.InstanceWide,
+	}
+}
+
+// ModificationRequest returns the request to update the site rule.
+func (s SiteRuleForm) ModificationRequest() *model.SiteRuleModificationRequest {
+	return &model.SiteRuleModificationRequest{
+		Domain:       &s.Domain,
+		ScraperRules: &s.ScraperRules,
+		RewriteRules: &s.RewriteRules,
+	}
+}
+
+// NewSiteRuleForm returns a new SiteRuleForm.
+func NewSiteRuleForm(r *http.Request) *SiteRuleForm {
+	return &SiteRuleForm{
+		Domain:       r.FormValue("domain"),
+		ScraperRules: r.FormValue("scraper_rules"),
+		RewriteRules: r.FormValue("rewrite_rules"),
+		InstanceWide: r.FormValue("instance_wide") == "1",
+	}
+}
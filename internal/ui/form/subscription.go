@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/validator"
 )
 
@@ -29,6 +30,7 @@ type SubscriptionForm struct {
 	KeeplistRules               string
 	UrlRewriteRules             string
 	DisableHTTP2                bool
+	PageSelectors               model.PageSelectors
 }
 
 // Validate makes sure the form values locale.are valid.
@@ -41,19 +43,23 @@ func (s *SubscriptionForm) Validate() *locale.LocalizedError {
 		return locale.NewLocalizedError("error.invalid_feed_url")
 	}
 
-	if !validator.IsValidRegex(s.BlocklistRules) {
-		return locale.NewLocalizedError("error.feed_invalid_blocklist_rule")
+	if validationErr := validator.ValidateFilterRules(s.BlocklistRules, "error.feed_invalid_blocklist_rule"); validationErr != nil {
+		return validationErr
 	}
 
-	if !validator.IsValidRegex(s.KeeplistRules) {
-		return locale.NewLocalizedError("error.feed_invalid_keeplist_rule")
+	if validationErr := validator.ValidateFilterRules(s.KeeplistRules, "error.feed_invalid_keeplist_rule"); validationErr != nil {
+		return validationErr
 	}
 
 	if !validator.IsValidRegex(s.UrlRewriteRules) {
 		return locale.NewLocalizedError("error.feed_invalid_urlrewrite_rule")
 	}
 
-	return nil
+	if validationErr := validator.ValidateRewriteRules(s.RewriteRules); validationErr != nil {
+		return validationErr
+	}
+
+	return validator.ValidatePageSelectors(&s.PageSelectors)
 }
 
 // NewSubscriptionForm returns a new SubscriptionForm.
@@ -80,5 +86,6 @@ func NewSubscriptionForm(r *http.Request) *SubscriptionForm {
 		KeeplistRules:               r.FormValue("keeplist_rules"),
 		UrlRewriteRules:             r.FormValue("urlrewrite_rules"),
 		DisableHTTP2:                r.FormValue("disable_http2") == "1",
+		PageSelectors:               NewPageSelectors(r),
 	}
 }
@@ -112,9 +112,23 @@ func (h *handler) showIntegrationPage(w http.ResponseWriter, r *http.Request) {
 		OmnivoreURL:                      integration.OmnivoreURL,
 	}
 
+	syndicationToken, err := h.store.SyndicationToken(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	categories, err := h.store.Categories(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
 	sess := session.New(h.store, request.SessionID(r))
 	view := view.New(h.tpl, r, sess)
 	view.Set("form", integrationForm)
+	view.Set("syndicationToken", syndicationToken)
+	view.Set("categories", categories)
 	view.Set("menu", "settings")
 	view.Set("user", user)
 	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) addToListeningQueue(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	enclosure, err := h.store.GetEnclosure(request.RouteInt64Param(r, "enclosureID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if enclosure == nil || enclosure.UserID != userID {
+		html.NotFound(w, r)
+		return
+	}
+
+	if err := validator.ValidateListeningQueueEnclosure(enclosure); err != nil {
+		html.BadRequest(w, r, err)
+		return
+	}
+
+	if err := h.store.AddToListeningQueue(userID, enclosure.ID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "listening"))
+}
+
+func (h *handler) removeFromListeningQueue(w http.ResponseWriter, r *http.Request) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	if err := h.store.RemoveFromListeningQueue(request.UserID(r), enclosureID); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "listening"))
+}
+
+func (h *handler) moveListeningQueueItem(w http.ResponseWriter, r *http.Request) {
+	userID := request.UserID(r)
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+
+	queue, err := h.store.ListeningQueue(userID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	enclosureIDs := make([]int64, len(queue))
+	for i, item := range queue {
+		enclosureIDs[i] = item.Enclosure.ID
+	}
+
+	for i, id := range enclosureIDs {
+		if id != enclosureID {
+			continue
+		}
+
+		j := i + 1
+		if request.RouteStringParam(r, "direction") == "up" {
+			j = i - 1
+		}
+
+		if j >= 0 && j < len(enclosureIDs) {
+			enclosureIDs[i], enclosureIDs[j] = enclosureIDs[j], enclosureIDs[i]
+			if err := h.store.ReorderListeningQueue(userID, enclosureIDs); err != nil {
+				html.ServerError(w, r, err)
+				return
+			}
+		}
+		break
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "listening"))
+}
+
+func (h *handler) markEnclosurePlayed(w http.ResponseWriter, r *http.Request) {
+	h.updateEnclosurePlayed(w, r, true)
+}
+
+func (h *handler) markEnclosureUnplayed(w http.ResponseWriter, r *http.Request) {
+	h.updateEnclosurePlayed(w, r, false)
+}
+
+func (h *handler) updateEnclosurePlayed(w http.ResponseWriter, r *http.Request, played bool) {
+	enclosureID := request.RouteInt64Param(r, "enclosureID")
+	if err := h.store.SetEnclosurePlayed(request.UserID(r), enclosureID, played); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "listening"))
+}
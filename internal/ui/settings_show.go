@@ -42,6 +42,10 @@ func (h *handler) showSettingsPage(w http.ResponseWriter, r *http.Request) {
 		CategoriesSortingOrder: user.CategoriesSortingOrder,
 		MarkReadOnView:         user.MarkReadOnView,
 		MediaPlaybackRate:      user.MediaPlaybackRate,
+		BlockFilterEntryRules:  user.BlockFilterEntryRules,
+		KeepFilterEntryRules:   user.KeepFilterEntryRules,
+		EntryActionRules:       user.EntryActionRules,
+		DeduplicateEntries:     user.DeduplicateEntries,
 	}
 
 	timezones, err := h.store.Timezones()
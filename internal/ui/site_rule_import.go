@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	json_parser "encoding/json"
+	"log/slog"
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) importSiteRules(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	file, fileHeader, err := r.FormFile("file")
+	if err != nil {
+		slog.Error("Site rules file upload error",
+			slog.Int64("user_id", user.ID),
+			slog.Any("error", err),
+		)
+
+		html.Redirect(w, r, route.Path(h.router, "siteRules"))
+		return
+	}
+	defer file.Close()
+
+	slog.Info("Site rules file uploaded",
+		slog.Int64("user_id", user.ID),
+		slog.String("file_name", fileHeader.Filename),
+		slog.Int64("file_size", fileHeader.Size),
+	)
+
+	var siteRuleRequests model.SiteRuleRequests
+	if err := json_parser.NewDecoder(file).Decode(&siteRuleRequests); err != nil {
+		h.showSiteRulesImportError(w, r, user, locale.NewLocalizedError("error.site_rule_invalid_file"))
+		return
+	}
+
+	if !user.IsAdmin {
+		siteRuleRequests.AsUserRules()
+	}
+
+	if validationErr := validator.ValidateSiteRuleImport(siteRuleRequests); validationErr != nil {
+		h.showSiteRulesImportError(w, r, user, validationErr)
+		return
+	}
+
+	if err := h.store.ImportSiteRules(user.ID, siteRuleRequests); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "siteRules"))
+}
+
+func (h *handler) showSiteRulesImportError(w http.ResponseWriter, r *http.Request, user *model.User, importErr *locale.LocalizedError) {
+	siteRules, err := h.store.SiteRules(user.ID)
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("siteRules", siteRules)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+	view.Set("errorMessage", importErr.Translate(user.Language))
+
+	html.OK(w, r, view.Render("site_rules"))
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package ui // import "miniflux.app/v2/internal/ui"
+
+import (
+	"net/http"
+
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/http/route"
+	"miniflux.app/v2/internal/ui/form"
+	"miniflux.app/v2/internal/ui/session"
+	"miniflux.app/v2/internal/ui/view"
+	"miniflux.app/v2/internal/validator"
+)
+
+func (h *handler) updateSiteRule(w http.ResponseWriter, r *http.Request) {
+	user, err := h.store.UserByID(request.UserID(r))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	siteRule, err := h.store.SiteRule(user.ID, request.RouteInt64Param(r, "siteRuleID"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if siteRule == nil {
+		html.NotFound(w, r)
+		return
+	}
+
+	if siteRule.InstanceWide && !user.IsAdmin {
+		html.Forbidden(w, r)
+		return
+	}
+
+	siteRuleForm := form.NewSiteRuleForm(r)
+	siteRuleForm.InstanceWide = siteRule.InstanceWide
+
+	sess := session.New(h.store, request.SessionID(r))
+	view := view.New(h.tpl, r, sess)
+	view.Set("form", siteRuleForm)
+	view.Set("siteRule", siteRule)
+	view.Set("menu", "settings")
+	view.Set("user", user)
+	view.Set("countUnread", h.store.CountUnreadEntries(user.ID))
+	view.Set("countErrorFeeds", h.store.CountUserFeedsWithErrors(user.ID))
+
+	modificationRequest := siteRuleForm.ModificationRequest()
+	if validationErr := validator.ValidateSiteRuleModification(h.store, user.ID, siteRule, modificationRequest); validationErr != nil {
+		view.Set("errorMessage", validationErr.Translate(user.Language))
+		html.OK(w, r, view.Render("edit_site_rule"))
+		return
+	}
+
+	modificationRequest.Patch(siteRule)
+	if err := h.store.UpdateSiteRule(siteRule); err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	html.Redirect(w, r, route.Path(h.router, "siteRules"))
+}
@@ -682,6 +682,76 @@ function handlePlayerProgressionSave(playerElement) {
     }
 }
 
+/**
+ * mark the media as played when the player reaches the end
+ * @param {Element} playerElement
+ */
+function handlePlayerEnded(playerElement) {
+    const currentPositionInSeconds = Math.floor(playerElement.currentTime);
+    playerElement.dataset.lastPosition = currentPositionInSeconds.toString();
+
+    const request = new RequestBuilder(playerElement.dataset.saveUrl);
+    request.withBody({ progression: currentPositionInSeconds, played: true });
+    request.execute();
+}
+
+/**
+ * Load the chapters of a podcast episode, clicking a chapter moves the player to its start time.
+ * @param {Element} chaptersElement
+ */
+function handlePodcastChapters(chaptersElement) {
+    if (chaptersElement.dataset.loaded === "true") {
+        return;
+    }
+    chaptersElement.dataset.loaded = "true";
+
+    const request = new RequestBuilder(chaptersElement.dataset.chaptersUrl);
+    request.withHttpMethod("GET");
+    request.withCallback((response) => {
+        if (!response.ok) {
+            return;
+        }
+
+        response.json().then((chapters) => {
+            const listElement = chaptersElement.querySelector("ol");
+            chapters.forEach((chapter) => {
+                const buttonElement = document.createElement("button");
+                buttonElement.type = "button";
+                buttonElement.textContent = formatPlayerTime(chapter.start_time) + " " + chapter.title;
+                buttonElement.onclick = () => {
+                    const playerElement = document.querySelector(":is(.entry-content, .entry-enclosures) :is(audio, video)");
+                    if (playerElement) {
+                        playerElement.currentTime = chapter.start_time;
+                        playerElement.play();
+                    }
+                };
+
+                const itemElement = document.createElement("li");
+                itemElement.appendChild(buttonElement);
+                listElement.appendChild(itemElement);
+            });
+        });
+    });
+    request.execute();
+}
+
+/**
+ * Format a number of seconds as "h:mm:ss" or "m:ss".
+ * @param {number} seconds
+ * @returns {string}
+ */
+function formatPlayerTime(seconds) {
+    const totalSeconds = Math.floor(seconds);
+    const hours = Math.floor(totalSeconds / 3600);
+    const minutes = Math.floor((totalSeconds % 3600) / 60);
+    const remainingSeconds = (totalSeconds % 60).toString().padStart(2, "0");
+
+    if (hours > 0) {
+        return hours + ":" + minutes.toString().padStart(2, "0") + ":" + remainingSeconds;
+    }
+    return minutes + ":" + remainingSeconds;
+}
+
 /**
  * handle new share entires and already shared entries
  */
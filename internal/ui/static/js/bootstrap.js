@@ -158,6 +158,16 @@ document.addEventListener("DOMContentLoaded", () => {
             element.currentTime = element.dataset.lastPosition;
         }
         element.ontimeupdate = () => handlePlayerProgressionSave(element);
+        element.onended = () => handlePlayerEnded(element);
+    });
+
+    // Load podcast chapters when the chapter list is opened
+    document.querySelectorAll("details[data-chapters-url]").forEach((element) => {
+        element.ontoggle = () => {
+            if (element.open) {
+                handlePodcastChapters(element);
+            }
+        };
     });
 
     // Set media playback rate
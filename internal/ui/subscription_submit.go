@@ -66,11 +66,18 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 	requestBuilder.IgnoreTLSErrors(subscriptionForm.AllowSelfSignedCertificates)
 	requestBuilder.DisableHTTP2(subscriptionForm.DisableHTTP2)
 
+	var subscriptions subscription.Subscriptions
+	var localizedError *locale.LocalizedErrorWrapper
 	subscriptionFinder := subscription.NewSubscriptionFinder(requestBuilder)
-	subscriptions, localizedError := subscriptionFinder.FindSubscriptions(
-		subscriptionForm.URL,
-		rssBridgeURL,
-	)
+	if subscriptionForm.PageSelectors.Item != "" {
+		// Feeds generated from a web page use the page URL, there is nothing to discover.
+		subscriptions = subscription.Subscriptions{subscription.NewSubscription(subscriptionForm.URL, subscriptionForm.URL, "")}
+	} else {
+		subscriptions, localizedError = subscriptionFinder.FindSubscriptions(
+			subscriptionForm.URL,
+			rssBridgeURL,
+		)
+	}
 	if localizedError != nil {
 		v.Set("form", subscriptionForm)
 		v.Set("errorMessage", localizedError.Translate(user.Language))
@@ -106,6 +113,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 				UrlRewriteRules:             subscriptionForm.UrlRewriteRules,
 				FetchViaProxy:               subscriptionForm.FetchViaProxy,
 				DisableHTTP2:                subscriptionForm.DisableHTTP2,
+				PageSelectors:               subscriptionForm.PageSelectors,
 			},
 		})
 		if localizedError != nil {
@@ -134,6 +142,7 @@ func (h *handler) submitSubscription(w http.ResponseWriter, r *http.Request) {
 			UrlRewriteRules:             subscriptionForm.UrlRewriteRules,
 			FetchViaProxy:               subscriptionForm.FetchViaProxy,
 			DisableHTTP2:                subscriptionForm.DisableHTTP2,
+			PageSelectors:               subscriptionForm.PageSelectors,
 		})
 		if localizedError != nil {
 			v.Set("form", subscriptionForm)
@@ -60,9 +60,18 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/search", handler.showSearchPage).Name("search").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/search/entry/{entryID}", handler.showSearchEntryPage).Name("searchEntry").Methods(http.MethodGet)
 
+	// Listening queue.
+	uiRouter.HandleFunc("/listening", handler.showListeningPage).Name("listening").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/listening/queue/{enclosureID}/add", handler.addToListeningQueue).Name("addToListeningQueue").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/listening/queue/{enclosureID}/remove", handler.removeFromListeningQueue).Name("removeFromListeningQueue").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/listening/queue/{enclosureID}/move/{direction:up|down}", handler.moveListeningQueueItem).Name("moveListeningQueueItem").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/listening/enclosure/{enclosureID}/played", handler.markEnclosurePlayed).Name("markEnclosurePlayed").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/listening/enclosure/{enclosureID}/unplayed", handler.markEnclosureUnplayed).Name("markEnclosureUnplayed").Methods(http.MethodPost)
+
 	// Feed listing pages.
 	uiRouter.HandleFunc("/feeds", handler.showFeedsPage).Name("feeds").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feeds/refresh", handler.refreshAllFeeds).Name("refreshAllFeeds").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/feeds/flaky", handler.showFlakyFeedsPage).Name("flakyFeeds").Methods(http.MethodGet)
 
 	// Individual feed pages.
 	uiRouter.HandleFunc("/feed/{feedID}/refresh", handler.refreshFeed).Name("refreshFeed").Methods(http.MethodGet, http.MethodPost)
@@ -76,6 +85,8 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/feed/{feedID}/entry/{entryID}", handler.showFeedEntryPage).Name("feedEntry").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/icon/{iconID}", handler.showIcon).Name("icon").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/feed/{feedID}/mark-all-as-read", handler.markFeedAsRead).Name("markFeedAsRead").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/feed/{feedID}/apply-filter-rules", handler.applyFeedFilterRules).Name("applyFeedFilterRules").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/feed/{feedID}/scraper-preview", handler.showScraperPreviewPage).Name("scraperPreview").Methods(http.MethodGet, http.MethodPost)
 
 	// Category pages.
 	uiRouter.HandleFunc("/category/{categoryID}/entry/{entryID}", handler.showCategoryEntryPage).Name("categoryEntry").Methods(http.MethodGet)
@@ -98,6 +109,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/entry/save/{entryID}", handler.saveEntry).Name("saveEntry").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/enclosure/{enclosureID}/save-progression", handler.saveEnclosureProgression).Name("saveEnclosureProgression").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/entry/download/{entryID}", handler.fetchContent).Name("fetchContent").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/entry/chapters/{entryID}", handler.fetchEntryChapters).Name("entryChapters").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/proxy/{encodedDigest}/{encodedURL}", handler.mediaProxy).Name("proxy").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/entry/bookmark/{entryID}", handler.toggleBookmark).Name("toggleBookmark").Methods(http.MethodPost)
 
@@ -120,6 +132,7 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/settings", handler.updateSettings).Name("updateSettings").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/integrations", handler.showIntegrationPage).Name("integrations").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/integration", handler.updateIntegration).Name("updateIntegration").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/integration/syndication/regenerate", handler.regenerateSyndicationToken).Name("regenerateSyndicationToken").Methods(http.MethodPost)
 	uiRouter.HandleFunc("/integration/pocket/authorize", handler.pocketAuthorize).Name("pocketAuthorize").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/integration/pocket/callback", handler.pocketCallback).Name("pocketCallback").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/about", handler.showAboutPage).Name("about").Methods(http.MethodGet)
@@ -134,6 +147,16 @@ func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
 	uiRouter.HandleFunc("/keys/create", handler.showCreateAPIKeyPage).Name("createAPIKey").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/keys/save", handler.saveAPIKey).Name("saveAPIKey").Methods(http.MethodPost)
 
+	// Site rules pages.
+	uiRouter.HandleFunc("/site-rules", handler.showSiteRulesPage).Name("siteRules").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/site-rules/create", handler.showCreateSiteRulePage).Name("createSiteRule").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/site-rules/save", handler.saveSiteRule).Name("saveSiteRule").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/site-rules/export", handler.exportSiteRules).Name("exportSiteRules").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/site-rules/import", handler.importSiteRules).Name("importSiteRules").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/site-rules/{siteRuleID}/edit", handler.showEditSiteRulePage).Name("editSiteRule").Methods(http.MethodGet)
+	uiRouter.HandleFunc("/site-rules/{siteRuleID}/update", handler.updateSiteRule).Name("updateSiteRule").Methods(http.MethodPost)
+	uiRouter.HandleFunc("/site-rules/{siteRuleID}/remove", handler.removeSiteRule).Name("removeSiteRule").Methods(http.MethodPost)
+
 	// OPML pages.
 	uiRouter.HandleFunc("/export", handler.exportFeeds).Name("export").Methods(http.MethodGet)
 	uiRouter.HandleFunc("/import", handler.showImportPage).Name("import").Methods(http.MethodGet)
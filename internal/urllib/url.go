@@ -5,7 +5,9 @@ package urllib // import "miniflux.app/v2/internal/urllib"
 
 import (
 	"fmt"
+	"mime"
 	"net/url"
+	"path"
 	"strings"
 )
 
@@ -102,3 +104,63 @@ func JoinBaseURLAndPath(baseURL, path string) (string, error) {
 
 	return finalURL, nil
 }
+
+// CanonicalURL returns a normalized version of the URL used to compare entries published by different feeds.
+//
+// The scheme, the "www." prefix, the fragment, the trailing slash and well-known
+// tracking parameters are removed, the remaining query parameters are sorted.
+func CanonicalURL(websiteURL string) string {
+	parsedURL, err := url.Parse(strings.TrimSpace(websiteURL))
+	if err != nil || parsedURL.Host == "" {
+		return websiteURL
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsedURL.Hostname()), "www.")
+	if port := parsedURL.Port(); port != "" && port != "80" && port != "443" {
+		host += ":" + port
+	}
+
+	query := parsedURL.Query()
+	for name := range query {
+		if isTrackingParameter(name) {
+			query.Del(name)
+		}
+	}
+
+	canonicalURL := host + strings.TrimSuffix(parsedURL.EscapedPath(), "/")
+	if encodedQuery := query.Encode(); encodedQuery != "" {
+		canonicalURL += "?" + encodedQuery
+	}
+
+	return canonicalURL
+}
+
+// ImageMimeType returns the image MIME type matching the file extension of the URL,
+// or an empty string when the URL doesn't look like an image.
+func ImageMimeType(imageURL string) string {
+	parsedURL, err := url.Parse(imageURL)
+	if err != nil {
+		return ""
+	}
+
+	mimeType, _, _ := mime.ParseMediaType(mime.TypeByExtension(strings.ToLower(path.Ext(parsedURL.Path))))
+	if !strings.HasPrefix(mimeType, "image/") {
+		return ""
+	}
+
+	return mimeType
+}
+
+func isTrackingParameter(name string) bool {
+	name = strings.ToLower(name)
+	if strings.HasPrefix(name, "utm_") {
+		return true
+	}
+
+	switch name {
+	case "fbclid", "gclid", "dclid", "msclkid", "mc_cid", "mc_eid", "yclid", "igshid", "ref", "ref_src":
+		return true
+	}
+
+	return false
+}
@@ -120,3 +120,40 @@ func TestJoinBaseURLAndPath(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalURL(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/article":                                "example.org/article",
+		"http://www.Example.org/article/":                            "example.org/article",
+		"https://example.org:443/article#comments":                   "example.org/article",
+		"https://example.org:8080/article":                           "example.org:8080/article",
+		"https://example.org/article?utm_source=rss&utm_medium=feed": "example.org/article",
+		"https://example.org/article?id=2&fbclid=abc&a=1":            "example.org/article?a=1&id=2",
+		"https://example.org/":                                       "example.org",
+		"not a url":                                                  "not a url",
+	}
+
+	for input, expected := range scenarios {
+		actual := CanonicalURL(input)
+		if actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
+
+func TestImageMimeType(t *testing.T) {
+	scenarios := map[string]string{
+		"https://example.org/photo.jpg":         "image/jpeg",
+		"https://example.org/photo.PNG?size=2":  "image/png",
+		"https://example.org/images/photo.webp": "image/webp",
+		"https://example.org/photo":             "",
+		"https://example.org/video.mp4":         "",
+		"https://example.org/page.html":         "",
+	}
+
+	for input, expected := range scenarios {
+		if actual := ImageMimeType(input); actual != expected {
+			t.Errorf(`Unexpected result for %q, got %q instead of %q`, input, actual, expected)
+		}
+	}
+}
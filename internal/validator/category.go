@@ -19,6 +19,10 @@ func ValidateCategoryCreation(store *storage.Storage, userID int64, request *mod
 		return locale.NewLocalizedError("error.category_already_exists")
 	}
 
+	if err := validateCategoryFilterRules(request); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -32,5 +36,25 @@ func ValidateCategoryModification(store *storage.Storage, userID, categoryID int
 		return locale.NewLocalizedError("error.category_already_exists")
 	}
 
+	if err := validateCategoryFilterRules(request); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateCategoryFilterRules(request *model.CategoryRequest) *locale.LocalizedError {
+	if request.BlocklistRules != nil {
+		if validationErr := ValidateFilterRules(*request.BlocklistRules, "error.feed_invalid_blocklist_rule"); validationErr != nil {
+			return validationErr
+		}
+	}
+
+	if request.KeeplistRules != nil {
+		if validationErr := ValidateFilterRules(*request.KeeplistRules, "error.feed_invalid_keeplist_rule"); validationErr != nil {
+			return validationErr
+		}
+	}
+
 	return nil
 }
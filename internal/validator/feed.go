@@ -6,6 +6,7 @@ package validator // import "miniflux.app/v2/internal/validator"
 import (
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/rewrite"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -27,15 +28,32 @@ func ValidateFeedCreation(store *storage.Storage, userID int64, request *model.F
 		return locale.NewLocalizedError("error.feed_category_not_found")
 	}
 
-	if !IsValidRegex(request.BlocklistRules) {
-		return locale.NewLocalizedError("error.feed_invalid_blocklist_rule")
+	if validationErr := ValidateFilterRules(request.BlocklistRules, "error.feed_invalid_blocklist_rule"); validationErr != nil {
+		return validationErr
 	}
 
-	if !IsValidRegex(request.KeeplistRules) {
-		return locale.NewLocalizedError("error.feed_invalid_keeplist_rule")
+	if validationErr := ValidateFilterRules(request.KeeplistRules, "error.feed_invalid_keeplist_rule"); validationErr != nil {
+		return validationErr
 	}
 
-	return nil
+	if validationErr := ValidateRewriteRules(request.RewriteRules); validationErr != nil {
+		return validationErr
+	}
+
+	return ValidatePageSelectors(&request.PageSelectors)
+}
+
+// ValidateFeedPreview validates the request to preview a feed before subscribing.
+func ValidateFeedPreview(request *model.FeedCreationRequest) *locale.LocalizedError {
+	if request.FeedURL == "" {
+		return locale.NewLocalizedError("error.feed_mandatory_fields")
+	}
+
+	if !IsValidURL(request.FeedURL) {
+		return locale.NewLocalizedError("error.invalid_feed_url")
+	}
+
+	return ValidatePageSelectors(&request.PageSelectors)
 }
 
 // ValidateFeedModification validates feed modification.
@@ -77,14 +95,51 @@ func ValidateFeedModification(store *storage.Storage, userID, feedID int64, requ
 	}
 
 	if request.BlocklistRules != nil {
-		if !IsValidRegex(*request.BlocklistRules) {
-			return locale.NewLocalizedError("error.feed_invalid_blocklist_rule")
+		if validationErr := ValidateFilterRules(*request.BlocklistRules, "error.feed_invalid_blocklist_rule"); validationErr != nil {
+			return validationErr
 		}
 	}
 
 	if request.KeeplistRules != nil {
-		if !IsValidRegex(*request.KeeplistRules) {
-			return locale.NewLocalizedError("error.feed_invalid_keeplist_rule")
+		if validationErr := ValidateFilterRules(*request.KeeplistRules, "error.feed_invalid_keeplist_rule"); validationErr != nil {
+			return validationErr
+		}
+	}
+
+	if request.RewriteRules != nil {
+		if validationErr := ValidateRewriteRules(*request.RewriteRules); validationErr != nil {
+			return validationErr
+		}
+	}
+
+	if request.PageSelectors != nil {
+		return ValidatePageSelectors(request.PageSelectors)
+	}
+
+	return nil
+}
+
+// ValidateRewriteRules validates the rewrite rules of a feed, the error contains the line of the invalid rule.
+func ValidateRewriteRules(rules string) *locale.LocalizedError {
+	if err := rewrite.ValidateRules(rules); err != nil {
+		return locale.NewLocalizedError("error.feed_invalid_rewrite_rule", err.Line, err.Message)
+	}
+
+	return nil
+}
+
+// ValidatePageSelectors validates the CSS selectors used to generate a feed from a web page.
+func ValidatePageSelectors(selectors *model.PageSelectors) *locale.LocalizedError {
+	if selectors.Item == "" {
+		if selectors.Title != "" || selectors.Link != "" || selectors.Date != "" || selectors.Content != "" {
+			return locale.NewLocalizedError("error.page_item_selector_required")
+		}
+		return nil
+	}
+
+	for _, selector := range []string{selectors.Item, selectors.Title, selectors.Link, selectors.Date, selectors.Content} {
+		if selector != "" && !IsValidCSSSelector(selector) {
+			return locale.NewLocalizedError("error.invalid_page_selector", selector)
 		}
 	}
 
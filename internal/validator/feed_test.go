@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"reflect"
+	"testing"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidatePageSelectors(t *testing.T) {
+	scenarios := []struct {
+		selectors model.PageSelectors
+		valid     bool
+	}{
+		{model.PageSelectors{}, true},
+		{model.PageSelectors{Item: "article"}, true},
+		{model.PageSelectors{Item: "article", Title: "h2", Link: "a.permalink", Date: "time", Content: ".summary"}, true},
+		{model.PageSelectors{Title: "h2"}, false},
+		{model.PageSelectors{Item: "article["}, false},
+		{model.PageSelectors{Item: "article", Date: "time["}, false},
+	}
+
+	for _, tc := range scenarios {
+		err := ValidatePageSelectors(&tc.selectors)
+		if (err == nil) != tc.valid {
+			t.Errorf(`Unexpected result for %+v, got %v`, tc.selectors, err)
+		}
+	}
+}
+
+func TestValidateRewriteRules(t *testing.T) {
+	if err := ValidateRewriteRules("add_image_title\nif_url(\"/news/\") remove(\".ads\")"); err != nil {
+		t.Errorf(`Valid rewrite rules should not generate any error, got %v`, err)
+	}
+
+	err := ValidateRewriteRules("add_image_title\nunknown_rule")
+	expected := locale.NewLocalizedError("error.feed_invalid_rewrite_rule", 2, `unknown rule "unknown_rule"`)
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf(`The error should contain the line of the invalid rule, got %+v`, err)
+	}
+}
@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateFilterRulesRequest validates the request to apply the filter rules to stored entries.
+func ValidateFilterRulesRequest(request *model.FilterRulesRequest) *locale.LocalizedError {
+	switch request.Action {
+	case model.FilterActionRead, model.FilterActionRemove:
+		return nil
+	}
+
+	return locale.NewLocalizedError("error.invalid_filter_action")
+}
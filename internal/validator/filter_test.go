@@ -0,0 +1,24 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateFilterRulesRequest(t *testing.T) {
+	for _, action := range []string{model.FilterActionRead, model.FilterActionRemove} {
+		if err := ValidateFilterRulesRequest(&model.FilterRulesRequest{Action: action}); err != nil {
+			t.Errorf(`A valid action should not generate any error, got %v`, err)
+		}
+	}
+
+	for _, action := range []string{"", "star", "removed"} {
+		if err := ValidateFilterRulesRequest(&model.FilterRulesRequest{Action: action}); err == nil {
+			t.Errorf(`The action %q should generate an error`, action)
+		}
+	}
+}
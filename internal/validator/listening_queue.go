@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"fmt"
+
+	"miniflux.app/v2/internal/model"
+)
+
+// ValidateListeningQueueOrder makes sure the new order of the listening queue is valid.
+func ValidateListeningQueueOrder(request *model.ListeningQueueOrderRequest) error {
+	if len(request.EnclosureIDs) == 0 {
+		return fmt.Errorf(`the list of enclosures cannot be empty`)
+	}
+
+	seen := make(map[int64]bool, len(request.EnclosureIDs))
+	for _, enclosureID := range request.EnclosureIDs {
+		if seen[enclosureID] {
+			return fmt.Errorf(`the enclosure #%d is listed more than once`, enclosureID)
+		}
+		seen[enclosureID] = true
+	}
+
+	return nil
+}
+
+// ValidateEnclosureModification makes sure the enclosure modification is valid.
+func ValidateEnclosureModification(request *model.EnclosureUpdateRequest) error {
+	if request.MediaProgression != nil && *request.MediaProgression < 0 {
+		return fmt.Errorf(`the media progression cannot be negative`)
+	}
+
+	return nil
+}
+
+// ValidateListeningQueueEnclosure makes sure the enclosure can be added to the listening queue.
+func ValidateListeningQueueEnclosure(enclosure *model.Enclosure) error {
+	if !enclosure.IsPlayable() {
+		return fmt.Errorf(`only audio and video enclosures can be added to the listening queue`)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"testing"
+
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateListeningQueueOrder(t *testing.T) {
+	if err := ValidateListeningQueueOrder(&model.ListeningQueueOrderRequest{EnclosureIDs: []int64{3, 1, 2}}); err != nil {
+		t.Error(`A valid order should not be rejected`)
+	}
+
+	if err := ValidateListeningQueueOrder(&model.ListeningQueueOrderRequest{}); err == nil {
+		t.Error(`An empty list of enclosures is not valid`)
+	}
+
+	if err := ValidateListeningQueueOrder(&model.ListeningQueueOrderRequest{EnclosureIDs: []int64{1, 2, 1}}); err == nil {
+		t.Error(`Duplicated enclosures are not valid`)
+	}
+}
+
+func TestValidateEnclosureModification(t *testing.T) {
+	progression := int64(42)
+	if err := ValidateEnclosureModification(&model.EnclosureUpdateRequest{MediaProgression: &progression}); err != nil {
+		t.Error(`A valid progression should not be rejected`)
+	}
+
+	progression = -1
+	if err := ValidateEnclosureModification(&model.EnclosureUpdateRequest{MediaProgression: &progression}); err == nil {
+		t.Error(`A negative progression is not valid`)
+	}
+}
+
+func TestValidateListeningQueueEnclosure(t *testing.T) {
+	if err := ValidateListeningQueueEnclosure(&model.Enclosure{MimeType: "audio/mpeg"}); err != nil {
+		t.Errorf(`Audio enclosures should be accepted, got %v`, err)
+	}
+
+	if err := ValidateListeningQueueEnclosure(&model.Enclosure{MimeType: "image/png"}); err == nil {
+		t.Error(`Image enclosures should be rejected`)
+	}
+}
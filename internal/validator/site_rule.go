@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"regexp"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/rewrite"
+	"miniflux.app/v2/internal/reader/scraper"
+	"miniflux.app/v2/internal/storage"
+)
+
+var siteRuleDomainRegex = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]*[a-z0-9])?\.)*[a-z0-9]([a-z0-9-]*[a-z0-9])?$`)
+
+// ValidateSiteRuleCreation validates site rule creation.
+func ValidateSiteRuleCreation(store *storage.Storage, userID int64, request *model.SiteRuleRequest) *locale.LocalizedError {
+	if err := ValidateSiteRule(request); err != nil {
+		return err
+	}
+
+	if store.SiteRuleDomainExists(userID, request.InstanceWide, request.Domain, 0) {
+		return locale.NewLocalizedError("error.site_rule_already_exists", model.NormalizeSiteRuleDomain(request.Domain))
+	}
+
+	return nil
+}
+
+// ValidateSiteRuleModification validates site rule modification.
+func ValidateSiteRuleModification(store *storage.Storage, userID int64, siteRule *model.SiteRule, request *model.SiteRuleModificationRequest) *locale.LocalizedError {
+	patchedSiteRule := *siteRule
+	request.Patch(&patchedSiteRule)
+
+	if err := ValidateSiteRule(&model.SiteRuleRequest{
+		Domain:       patchedSiteRule.Domain,
+		ScraperRules: patchedSiteRule.ScraperRules,
+		RewriteRules: patchedSiteRule.RewriteRules,
+	}); err != nil {
+		return err
+	}
+
+	if store.SiteRuleDomainExists(userID, siteRule.InstanceWide, patchedSiteRule.Domain, siteRule.ID) {
+		return locale.NewLocalizedError("error.site_rule_already_exists", patchedSiteRule.Domain)
+	}
+
+	return nil
+}
+
+// ValidateSiteRuleImport validates all the site rules of an imported list, before any of them is saved.
+func ValidateSiteRuleImport(requests model.SiteRuleRequests) *locale.LocalizedError {
+	for _, request := range requests {
+		if err := ValidateSiteRule(request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateSiteRule validates the domain, the scraper rules and the rewrite rules of a site rule.
+func ValidateSiteRule(request *model.SiteRuleRequest) *locale.LocalizedError {
+	domain := model.NormalizeSiteRuleDomain(request.Domain)
+	if !siteRuleDomainRegex.MatchString(domain) {
+		return locale.NewLocalizedError("error.site_rule_invalid_domain", request.Domain)
+	}
+
+	if request.ScraperRules == "" && request.RewriteRules == "" {
+		return locale.NewLocalizedError("error.site_rule_empty", domain)
+	}
+
+	if err := scraper.ValidateRules(request.ScraperRules); err != nil {
+		return locale.NewLocalizedError("error.site_rule_invalid_scraper_rules", domain, err)
+	}
+
+	if err := rewrite.ValidateRules(request.RewriteRules); err != nil {
+		return locale.NewLocalizedError("error.site_rule_invalid_rewrite_rule", domain, err.Line, err.Message)
+	}
+
+	return nil
+}
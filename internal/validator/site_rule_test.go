@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package validator // import "miniflux.app/v2/internal/validator"
+
+import (
+	"reflect"
+	"testing"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestValidateSiteRule(t *testing.T) {
+	scenarios := []struct {
+		request model.SiteRuleRequest
+		valid   bool
+	}{
+		{model.SiteRuleRequest{Domain: "example.org", ScraperRules: "article"}, true},
+		{model.SiteRuleRequest{Domain: " WWW.Example.org ", RewriteRules: "add_image_title"}, true},
+		{model.SiteRuleRequest{Domain: "blog.example.co.uk", ScraperRules: "div.post; next_page: a.next", RewriteRules: `remove(".ads")`}, true},
+		{model.SiteRuleRequest{Domain: "", ScraperRules: "article"}, false},
+		{model.SiteRuleRequest{Domain: "https://example.org/", ScraperRules: "article"}, false},
+		{model.SiteRuleRequest{Domain: "-example.org", ScraperRules: "article"}, false},
+		{model.SiteRuleRequest{Domain: "example.org"}, false},
+		{model.SiteRuleRequest{Domain: "example.org", ScraperRules: "article["}, false},
+		{model.SiteRuleRequest{Domain: "example.org", ScraperRules: "article; next_page: a["}, false},
+		{model.SiteRuleRequest{Domain: "example.org", RewriteRules: "unknown_rule"}, false},
+	}
+
+	for _, tc := range scenarios {
+		err := ValidateSiteRule(&tc.request)
+		if (err == nil) != tc.valid {
+			t.Errorf(`Unexpected result for %+v, got %v`, tc.request, err)
+		}
+	}
+}
+
+func TestValidateSiteRuleImport(t *testing.T) {
+	requests := model.SiteRuleRequests{
+		{Domain: "example.org", ScraperRules: "article"},
+		{Domain: "example.com", RewriteRules: "add_image_title\nremove(\"[\")"},
+	}
+
+	err := ValidateSiteRuleImport(requests)
+	expected := locale.NewLocalizedError("error.site_rule_invalid_rewrite_rule", "example.com", 2, `"remove" has an invalid CSS selector "["`)
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf(`Unexpected error, got %v instead of %v`, err, expected)
+	}
+
+	if err := ValidateSiteRuleImport(requests[:1]); err != nil {
+		t.Errorf(`Valid site rules should not generate any error, got %v`, err)
+	}
+}
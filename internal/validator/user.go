@@ -4,8 +4,10 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"miniflux.app/v2/internal/integration"
 	"miniflux.app/v2/internal/locale"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/filter"
 	"miniflux.app/v2/internal/storage"
 )
 
@@ -108,6 +110,24 @@ func ValidateUserModification(store *storage.Storage, userID int64, changes *mod
 		}
 	}
 
+	if changes.BlockFilterEntryRules != nil {
+		if validationErr := ValidateFilterRules(*changes.BlockFilterEntryRules, "error.settings_invalid_block_filter_entry_rules"); validationErr != nil {
+			return validationErr
+		}
+	}
+
+	if changes.KeepFilterEntryRules != nil {
+		if validationErr := ValidateFilterRules(*changes.KeepFilterEntryRules, "error.settings_invalid_keep_filter_entry_rules"); validationErr != nil {
+			return validationErr
+		}
+	}
+
+	if changes.EntryActionRules != nil {
+		if err := validateEntryActionRules(*changes.EntryActionRules); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -195,3 +215,17 @@ func validateMediaPlaybackRate(mediaPlaybackRate float64) *locale.LocalizedError
 	}
 	return nil
 }
+
+func validateEntryActionRules(rules string) *locale.LocalizedError {
+	actionRules, err := filter.ParseActionRules(rules)
+	if err != nil {
+		return locale.NewLocalizedError("error.settings_invalid_entry_action_rules")
+	}
+
+	for _, name := range actionRules.Integrations() {
+		if !integration.IsSavingIntegration(name) {
+			return locale.NewLocalizedError("error.settings_unknown_action_integration", name)
+		}
+	}
+	return nil
+}
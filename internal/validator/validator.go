@@ -4,9 +4,15 @@
 package validator // import "miniflux.app/v2/internal/validator"
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
+
+	"miniflux.app/v2/internal/locale"
+	"miniflux.app/v2/internal/reader/filter"
+
+	"github.com/andybalholm/cascadia"
 )
 
 // ValidateRange makes sure the offset/limit values are valid.
@@ -38,6 +44,28 @@ func IsValidRegex(expr string) bool {
 	return err == nil
 }
 
+// ValidateFilterRules verifies if the block or keep list rules can be parsed.
+// The error message gives the line number of the first invalid rule.
+func ValidateFilterRules(rules, messageKey string) *locale.LocalizedError {
+	err := filter.Validate(rules)
+	if err == nil {
+		return nil
+	}
+
+	var parseErr *filter.ParseError
+	if errors.As(err, &parseErr) {
+		return locale.NewLocalizedError(messageKey, parseErr.Line, parseErr.Err.Error())
+	}
+
+	return locale.NewLocalizedError(messageKey, 0, err.Error())
+}
+
+// IsValidCSSSelector verifies if the CSS selector can be compiled.
+func IsValidCSSSelector(selector string) bool {
+	_, err := cascadia.Compile(selector)
+	return err == nil
+}
+
 // IsValidURL verifies if the provided value is a valid absolute URL.
 func IsValidURL(absoluteURL string) bool {
 	_, err := url.ParseRequestURI(absoluteURL)
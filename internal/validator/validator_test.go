@@ -3,7 +3,12 @@
 
 package validator // import "miniflux.app/v2/internal/validator"
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	"miniflux.app/v2/internal/locale"
+)
 
 func TestIsValidURL(t *testing.T) {
 	scenarios := map[string]bool{
@@ -59,3 +64,46 @@ func TestIsValidRegex(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateFilterRules(t *testing.T) {
+	scenarios := map[string]bool{
+		"(?i)miniflux":                  true,
+		"title~=(?i)sponsored\ntag=ads": true,
+		"date<30d && author==Bot":       true,
+		"[":                             false,
+		"date<30":                       false,
+		"titel==miniflux":               false,
+	}
+
+	for rules, expected := range scenarios {
+		result := ValidateFilterRules(rules, "error.feed_invalid_blocklist_rule") == nil
+		if result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, rules, result, expected)
+		}
+	}
+}
+
+func TestValidateFilterRulesLineNumber(t *testing.T) {
+	err := ValidateFilterRules("title~=(?i)sponsored\n\ntitel==miniflux", "error.feed_invalid_blocklist_rule")
+	expected := locale.NewLocalizedError("error.feed_invalid_blocklist_rule", 3, `unknown field "titel"`)
+	if !reflect.DeepEqual(err, expected) {
+		t.Errorf(`Unexpected error, got %v instead of %v`, err, expected)
+	}
+}
+
+func TestIsValidCSSSelector(t *testing.T) {
+	scenarios := map[string]bool{
+		"article.post":       true,
+		"ul > li a[href]":    true,
+		"h2, h3":             true,
+		"article[":           false,
+		"div:not-a-pseudo()": false,
+	}
+
+	for selector, expected := range scenarios {
+		result := IsValidCSSSelector(selector)
+		if result != expected {
+			t.Errorf(`Unexpected result for %q, got %v instead of %v`, selector, result, expected)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/http/request"
+	"miniflux.app/v2/internal/http/response"
+	"miniflux.app/v2/internal/http/response/html"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/storage"
+	"miniflux.app/v2/internal/worker"
+
+	"github.com/gorilla/mux"
+)
+
+// Serve declares the WebSub callback routes.
+func Serve(router *mux.Router, store *storage.Storage, pool *worker.Pool) {
+	h := &callbackHandler{store, pool}
+	router.HandleFunc("/websub/{callbackToken}", h.verifyIntent).Methods(http.MethodGet).Name("webSubVerify")
+	router.HandleFunc("/websub/{callbackToken}", h.receiveContent).Methods(http.MethodPost).Name("webSubContent")
+}
+
+type callbackHandler struct {
+	store *storage.Storage
+	pool  *worker.Pool
+}
+
+// verifyIntent answers the hub when it confirms a subscription or reports that it has been denied.
+func (h *callbackHandler) verifyIntent(w http.ResponseWriter, r *http.Request) {
+	subscription, err := h.store.WebSubSubscriptionByToken(request.RouteStringParam(r, "callbackToken"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil || r.URL.Query().Get("hub.topic") != subscription.TopicURL {
+		html.NotFound(w, r)
+		return
+	}
+
+	switch r.URL.Query().Get("hub.mode") {
+	case "subscribe":
+		lease := LeaseDuration
+		if leaseSeconds, err := strconv.Atoi(r.URL.Query().Get("hub.lease_seconds")); err == nil && leaseSeconds > 0 {
+			lease = time.Duration(leaseSeconds) * time.Second
+		}
+
+		if err := h.store.VerifyWebSubSubscription(subscription.FeedID, lease); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		slog.Info("WebSub subscription verified",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.Duration("lease", lease),
+		)
+
+		builder := response.New(w, r)
+		builder.WithHeader("Content-Type", "text/plain; charset=utf-8")
+		builder.WithBody(r.URL.Query().Get("hub.challenge"))
+		builder.Write()
+	case "denied":
+		if err := h.store.DenyWebSubSubscription(subscription.FeedID); err != nil {
+			html.ServerError(w, r, err)
+			return
+		}
+
+		slog.Warn("WebSub subscription denied by the hub",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("hub_url", subscription.HubURL),
+			slog.String("reason", r.URL.Query().Get("hub.reason")),
+		)
+
+		response.New(w, r).Write()
+	default:
+		// We never unsubscribe while the callback token exists.
+		html.NotFound(w, r)
+	}
+}
+
+// receiveContent processes the new content of the topic pushed by the hub.
+func (h *callbackHandler) receiveContent(w http.ResponseWriter, r *http.Request) {
+	subscription, err := h.store.WebSubSubscriptionByToken(request.RouteStringParam(r, "callbackToken"))
+	if err != nil {
+		html.ServerError(w, r, err)
+		return
+	}
+
+	if subscription == nil || subscription.State != model.WebSubStateVerified {
+		html.NotFound(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, config.Opts.HTTPClientMaxBodySize()))
+	if err != nil {
+		html.BadRequest(w, r, err)
+		return
+	}
+
+	// The hub must receive a success response even if the signature is invalid, the content is ignored.
+	if !VerifySignature(subscription.Secret, r.Header.Get("X-Hub-Signature"), body) {
+		slog.Warn("Ignoring WebSub content with an invalid signature",
+			slog.Int64("user_id", subscription.UserID),
+			slog.Int64("feed_id", subscription.FeedID),
+			slog.String("client_ip", request.ClientIP(r)),
+		)
+		response.New(w, r).WithStatus(http.StatusAccepted).Write()
+		return
+	}
+
+	// The content is processed by the workers, like the feed refreshes.
+	h.pool.PushContent(subscription.UserID, subscription.FeedID, body)
+
+	response.New(w, r).WithStatus(http.StatusAccepted).Write()
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+/*
+Package websub implements a WebSub subscriber (https://www.w3.org/TR/websub/).
+
+Feeds advertising a hub with <link rel="hub"> are recorded while refreshing the feed,
+the scheduler sends the subscription requests and renews the leases, and the hub
+pushes the new content to the callback URL served by this package.
+*/
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/fetcher"
+)
+
+// LeaseDuration is the subscription lease requested to the hubs, they may grant a different one.
+const LeaseDuration = 10 * 24 * time.Hour
+
+// CallbackURL returns the URL where the hub verifies the subscription and pushes the content.
+func CallbackURL(callbackToken string) string {
+	return config.Opts.BaseURL() + "/websub/" + callbackToken
+}
+
+// Subscribe sends a subscription request to the hub, the hub verifies the intent asynchronously.
+func Subscribe(subscription *model.WebSubSubscription) error {
+	values := url.Values{}
+	values.Set("hub.mode", "subscribe")
+	values.Set("hub.topic", subscription.TopicURL)
+	values.Set("hub.callback", CallbackURL(subscription.CallbackToken))
+	values.Set("hub.lease_seconds", strconv.Itoa(int(LeaseDuration.Seconds())))
+
+	// The content pushed by the hub is always signed with the secret, unsigned content is rejected.
+	values.Set("hub.secret", subscription.Secret)
+
+	requestBuilder := fetcher.NewRequestBuilder()
+	requestBuilder.WithUserAgent("", config.Opts.HTTPClientUserAgent())
+	requestBuilder.WithTimeout(config.Opts.HTTPClientTimeout())
+	requestBuilder.WithProxy(config.Opts.HTTPClientProxy())
+
+	response, err := requestBuilder.PostForm(subscription.HubURL, values)
+	if err != nil {
+		return fmt.Errorf("websub: unable to send request: %v", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("websub: unexpected status code from hub: %d", response.StatusCode)
+	}
+
+	return nil
+}
+
+// VerifySignature checks the X-Hub-Signature header sent with the content, for example "sha256=<hex digest>".
+func VerifySignature(secret, signature string, body []byte) bool {
+	method, digest, found := strings.Cut(signature, "=")
+	if !found {
+		return false
+	}
+
+	var newHash func() hash.Hash
+	switch strings.ToLower(method) {
+	case "sha1":
+		newHash = sha1.New
+	case "sha256":
+		newHash = sha256.New
+	case "sha384":
+		newHash = sha512.New384
+	case "sha512":
+		newHash = sha512.New
+	default:
+		return false
+	}
+
+	expected, err := hex.DecodeString(digest)
+	if err != nil {
+		return false
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
@@ -0,0 +1,79 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package websub // import "miniflux.app/v2/internal/websub"
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"miniflux.app/v2/internal/config"
+	"miniflux.app/v2/internal/model"
+)
+
+func TestVerifySignature(t *testing.T) {
+	secret := "secret"
+	body := []byte("<feed></feed>")
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	if !VerifySignature(secret, signature, body) {
+		t.Error(`A valid signature should be accepted`)
+	}
+
+	scenarios := []struct {
+		secret    string
+		signature string
+		body      []byte
+	}{
+		{"other secret", signature, body},
+		{secret, signature, []byte("<feed>modified</feed>")},
+		{secret, "", body},
+		{secret, "sha256", body},
+		{secret, "md5=" + hex.EncodeToString(mac.Sum(nil)), body},
+		{secret, "sha256=invalid", body},
+	}
+
+	for _, tc := range scenarios {
+		if VerifySignature(tc.secret, tc.signature, tc.body) {
+			t.Errorf(`Signature %q should be rejected`, tc.signature)
+		}
+	}
+}
+
+func TestSubscribeSendsSecret(t *testing.T) {
+	config.Opts = config.NewOptions()
+
+	var secret, userAgent string
+	hub := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		secret = r.PostFormValue("hub.secret")
+		userAgent = r.UserAgent()
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer hub.Close()
+
+	subscription := &model.WebSubSubscription{
+		HubURL:        hub.URL,
+		TopicURL:      "http://example.org/feed.xml",
+		CallbackToken: "token",
+		Secret:        "secret",
+	}
+
+	if err := Subscribe(subscription); err != nil {
+		t.Fatalf(`Unexpected error: %v`, err)
+	}
+
+	if secret != subscription.Secret {
+		t.Errorf(`The secret should be sent to plain HTTP hubs, got %q`, secret)
+	}
+
+	if userAgent != config.Opts.HTTPClientUserAgent() {
+		t.Errorf(`Unexpected user agent, got %q`, userAgent)
+	}
+}
@@ -4,32 +4,101 @@
 package worker // import "miniflux.app/v2/internal/worker"
 
 import (
+	"log/slog"
+	"time"
+
 	"miniflux.app/v2/internal/model"
 	"miniflux.app/v2/internal/storage"
 )
 
+const (
+	// staleJobTimeout is the duration after which a running job is considered abandoned, for example after a crash.
+	staleJobTimeout = 30 * time.Minute
+
+	// staleJobCheckInterval is the interval between two checks of abandoned jobs.
+	staleJobCheckInterval = 5 * time.Minute
+)
+
 // Pool handles a pool of workers.
+//
+// Jobs are stored in the database, they survive restarts and can be shared by several instances.
 type Pool struct {
-	queue chan model.Job
+	store  *storage.Storage
+	wakeup chan struct{}
 }
 
 // Push send a list of jobs to the queue.
 func (p *Pool) Push(jobs model.JobList) {
-	for _, job := range jobs {
-		p.queue <- job
+	count, err := p.store.EnqueueJobs(jobs)
+	if err != nil {
+		slog.Error("Unable to enqueue jobs",
+			slog.Int("nb_jobs", len(jobs)),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	slog.Debug("Jobs sent to the queue",
+		slog.Int("nb_jobs", len(jobs)),
+		slog.Int64("nb_queued_jobs", count),
+	)
+
+	p.notify()
+}
+
+// PushContent sends the feed content pushed by a WebSub hub to the queue.
+func (p *Pool) PushContent(userID, feedID int64, payload []byte) {
+	if err := p.store.EnqueuePushedContent(userID, feedID, payload); err != nil {
+		slog.Error("Unable to enqueue pushed content",
+			slog.Int64("user_id", userID),
+			slog.Int64("feed_id", feedID),
+			slog.Any("error", err),
+		)
+		return
+	}
+
+	p.notify()
+}
+
+// notify wakes up idle workers without blocking.
+func (p *Pool) notify() {
+	for {
+		select {
+		case p.wakeup <- struct{}{}:
+		default:
+			return
+		}
+	}
+}
+
+func (p *Pool) requeueStaleJobs() {
+	for range time.Tick(staleJobCheckInterval) {
+		count, err := p.store.RequeueStaleJobs(staleJobTimeout)
+		if err != nil {
+			slog.Error("Unable to requeue stale jobs", slog.Any("error", err))
+			continue
+		}
+
+		if count > 0 {
+			slog.Warn("Requeued stale jobs", slog.Int64("nb_jobs", count))
+			p.notify()
+		}
 	}
 }
 
 // NewPool creates a pool of background workers.
 func NewPool(store *storage.Storage, nbWorkers int) *Pool {
 	workerPool := &Pool{
-		queue: make(chan model.Job),
+		store:  store,
+		wakeup: make(chan struct{}, nbWorkers),
 	}
 
 	for i := range nbWorkers {
 		worker := &Worker{id: i, store: store}
-		go worker.Run(workerPool.queue)
+		go worker.Run(workerPool.wakeup)
 	}
 
+	go workerPool.requeueStaleJobs()
+
 	return workerPool
 }
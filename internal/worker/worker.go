@@ -10,10 +10,20 @@ import (
 	"miniflux.app/v2/internal/config"
 	"miniflux.app/v2/internal/metric"
 	"miniflux.app/v2/internal/model"
+	"miniflux.app/v2/internal/reader/fetcher"
 	feedHandler "miniflux.app/v2/internal/reader/handler"
 	"miniflux.app/v2/internal/storage"
 )
 
+const (
+	// pollingInterval is the maximum time an idle worker waits before looking for jobs in the queue,
+	// jobs may have been delayed or queued by another instance.
+	pollingInterval = 10 * time.Second
+
+	// maxRetryDelay caps the exponential backoff between two attempts.
+	maxRetryDelay = time.Hour
+)
+
 // Worker refreshes a feed in the background.
 type Worker struct {
 	id    int
@@ -21,36 +31,122 @@ type Worker struct {
 }
 
 // Run wait for a job and refresh the given feed.
-func (w *Worker) Run(c <-chan model.Job) {
+func (w *Worker) Run(wakeup <-chan struct{}) {
 	slog.Debug("Worker started",
 		slog.Int("worker_id", w.id),
 	)
 
-	for {
-		job := <-c
-		slog.Debug("Job received by worker",
-			slog.Int("worker_id", w.id),
-			slog.Int64("user_id", job.UserID),
-			slog.Int64("feed_id", job.FeedID),
-		)
+	ticker := time.NewTicker(pollingInterval)
+	defer ticker.Stop()
 
-		startTime := time.Now()
-		localizedError := feedHandler.RefreshFeed(w.store, job.UserID, job.FeedID, false)
+	for {
+		job, err := w.store.ClaimJob()
+		if err != nil {
+			slog.Error("Unable to fetch a job from the queue",
+				slog.Int("worker_id", w.id),
+				slog.Any("error", err),
+			)
+		}
 
-		if config.Opts.HasMetricsCollector() {
-			status := "success"
-			if localizedError != nil {
-				status = "error"
+		if job == nil {
+			select {
+			case <-wakeup:
+			case <-ticker.C:
 			}
-			metric.BackgroundFeedRefreshDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
+			continue
 		}
 
+		w.process(job)
+	}
+}
+
+func (w *Worker) process(job *model.Job) {
+	slog.Debug("Job received by worker",
+		slog.Int("worker_id", w.id),
+		slog.Int64("job_id", job.ID),
+		slog.Int64("user_id", job.UserID),
+		slog.Int64("feed_id", job.FeedID),
+		slog.Int("attempt", job.Attempts),
+	)
+
+	if len(job.Payload) > 0 {
+		w.processPushedContent(job)
+		return
+	}
+
+	startTime := time.Now()
+	willRetry := job.Attempts < config.Opts.WorkerMaxAttempts()
+	localizedError := feedHandler.RefreshFeedWithRetry(w.store, job.UserID, job.FeedID, willRetry)
+
+	if config.Opts.HasMetricsCollector() {
+		status := "success"
 		if localizedError != nil {
-			slog.Warn("Unable to refresh a feed",
-				slog.Int64("user_id", job.UserID),
-				slog.Int64("feed_id", job.FeedID),
-				slog.Any("error", localizedError.Error()),
+			status = "error"
+		}
+		metric.BackgroundFeedRefreshDuration.WithLabelValues(status).Observe(time.Since(startTime).Seconds())
+	}
+
+	if localizedError != nil && willRetry && fetcher.IsTransientError(localizedError.Error()) {
+		delay := retryDelay(job.Attempts, time.Duration(config.Opts.WorkerRetryDelay())*time.Second)
+		delay = max(delay, fetcher.RetryAfterDelay(localizedError.Error()))
+
+		slog.Warn("Unable to refresh a feed, retrying later",
+			slog.Int64("user_id", job.UserID),
+			slog.Int64("feed_id", job.FeedID),
+			slog.Int("attempt", job.Attempts),
+			slog.Duration("retry_in", delay),
+			slog.Any("error", localizedError.Error()),
+		)
+
+		if err := w.store.RetryJob(job.ID, time.Now().Add(delay), localizedError.Error().Error()); err != nil {
+			slog.Error("Unable to reschedule job",
+				slog.Int64("job_id", job.ID),
+				slog.Any("error", err),
 			)
 		}
+		return
+	}
+
+	if localizedError != nil {
+		slog.Warn("Unable to refresh a feed",
+			slog.Int64("user_id", job.UserID),
+			slog.Int64("feed_id", job.FeedID),
+			slog.Int("attempt", job.Attempts),
+			slog.Any("error", localizedError.Error()),
+		)
+	}
+
+	if err := w.store.RemoveJob(job.ID); err != nil {
+		slog.Error("Unable to remove job from the queue",
+			slog.Int64("job_id", job.ID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// processPushedContent stores the feed content pushed by a WebSub hub, parsing errors are not retried.
+func (w *Worker) processPushedContent(job *model.Job) {
+	if localizedError := feedHandler.ProcessPushedFeed(w.store, job.UserID, job.FeedID, job.Payload); localizedError != nil {
+		slog.Warn("Unable to process WebSub content",
+			slog.Int64("user_id", job.UserID),
+			slog.Int64("feed_id", job.FeedID),
+			slog.Any("error", localizedError.Error()),
+		)
+	}
+
+	if err := w.store.RemoveJob(job.ID); err != nil {
+		slog.Error("Unable to remove job from the queue",
+			slog.Int64("job_id", job.ID),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// retryDelay returns the delay before the next attempt, doubled after each failed attempt.
+func retryDelay(attempts int, baseDelay time.Duration) time.Duration {
+	delay := baseDelay
+	for i := 1; i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
 	}
+	return min(delay, maxRetryDelay)
 }
@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: Copyright The Miniflux Authors. All rights reserved.
+// SPDX-License-Identifier: Apache-2.0
+
+package worker // import "miniflux.app/v2/internal/worker"
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryDelay(t *testing.T) {
+	scenarios := []struct {
+		attempts int
+		expected time.Duration
+	}{
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{4, 8 * time.Minute},
+		{10, time.Hour},
+	}
+
+	for _, tc := range scenarios {
+		if result := retryDelay(tc.attempts, time.Minute); result != tc.expected {
+			t.Errorf(`Unexpected delay for attempt %d, got %v instead of %v`, tc.attempts, result, tc.expected)
+		}
+	}
+}